@@ -0,0 +1,137 @@
+package validation
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// TruncatedErrorKey is the key in Errors under which ErrTruncated is reported when validation stops early
+// because the maximum number of errors set by WithMaxErrors is reached.
+var TruncatedErrorKey = "_truncated"
+
+// ErrTruncated is the error that returns when validation stops early because the maximum number of errors is reached.
+// The "skipped" parameter is the number of values (elements, keys or fields) that were not validated.
+var ErrTruncated = NewError("validation_truncated", "{{.skipped}} more values were not validated after reaching the maximum of {{.max}} errors")
+
+type (
+	maxErrorsKey struct{}
+
+	// errorBudget counts the errors found during a validation run.
+	errorBudget struct {
+		max   int64
+		count atomic.Int64
+	}
+
+	// errorCollector records the errors found for the elements, keys or fields of a single value
+	// and charges them to the error budget carried by the context, if any.
+	errorCollector struct {
+		budget  *errorBudget
+		errs    Errors
+		skipped int
+	}
+)
+
+// WithMaxErrors returns a copy of ctx with an error budget allowing at most max errors. When validating with
+// the returned context, Each, Map, ValidateStruct, Struct and the validation of maps, slices and arrays
+// of Validatable elements stop once the budget is exhausted. The values that are not validated are reported
+// by ErrTruncated under TruncatedErrorKey in the Errors of the value being validated. For example,
+//
+//	err := validation.ValidateWithContext(validation.WithMaxErrors(ctx, 100), items, validation.Each(validation.Required))
+//
+// The budget is shared by all validations using the returned context, so it should be created for each validation run.
+// A maximum that is not positive removes the limit. If ctx is nil, context.Background() is used.
+func WithMaxErrors(ctx context.Context, max int) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var budget *errorBudget
+	if max > 0 {
+		budget = &errorBudget{max: int64(max)}
+	}
+	return context.WithValue(ctx, maxErrorsKey{}, budget)
+}
+
+func newErrorCollector(ctx context.Context, errs Errors) *errorCollector {
+	c := &errorCollector{errs: errs}
+	if ctx != nil {
+		c.budget, _ = ctx.Value(maxErrorsKey{}).(*errorBudget)
+	}
+	return c
+}
+
+// stop checks if the error budget is exhausted. If so, it records that the given number of values are skipped.
+func (c *errorCollector) stop(remaining int) bool {
+	if c.budget == nil || c.budget.count.Load() < c.budget.max {
+		return false
+	}
+	c.skipped += remaining
+	return true
+}
+
+// mark returns the number of errors charged to the budget so far.
+func (c *errorCollector) mark() int64 {
+	if c.budget == nil {
+		return 0
+	}
+	return c.budget.count.Load()
+}
+
+// add records err under key and charges it to the budget.
+// mark is the result of calling mark() before validating the value err is returned for.
+func (c *errorCollector) add(key string, err error, mark int64) {
+	if err == nil {
+		return
+	}
+	c.errs[key] = err
+	if c.budget != nil {
+		c.charge(countErrors(err), mark)
+	}
+}
+
+// count returns the number of errors recorded so far, or 0 if there is no budget to charge them to.
+func (c *errorCollector) count() int {
+	if c.budget == nil {
+		return 0
+	}
+	return countErrors(c.errs)
+}
+
+// charge charges n errors found since mark to the budget,
+// leaving out those already charged by the nested validations since then.
+func (c *errorCollector) charge(n int, mark int64) {
+	if c.budget == nil {
+		return
+	}
+	if n := int64(n) - (c.budget.count.Load() - mark); n > 0 {
+		c.budget.count.Add(n)
+	}
+}
+
+// result returns the recorded errors, if any, along with ErrTruncated if any values were skipped.
+func (c *errorCollector) result() error {
+	if c.skipped > 0 {
+		c.errs[TruncatedErrorKey] = ErrTruncated.SetParams(map[string]interface{}{
+			"skipped": c.skipped,
+			"max":     int(c.budget.max),
+		})
+	}
+	if len(c.errs) > 0 {
+		return c.errs
+	}
+	return nil
+}
+
+// countErrors returns the number of errors in err, counting each of the errors nested in Errors.
+func countErrors(err error) int {
+	es, ok := err.(Errors)
+	if !ok {
+		return 1
+	}
+	n := 0
+	for _, e := range es {
+		if e != nil {
+			n += countErrors(e)
+		}
+	}
+	return n
+}
@@ -0,0 +1,112 @@
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMaxErrors(t *testing.T) {
+	values := make([]string, 1000)
+
+	err := validation.ValidateWithContext(validation.WithMaxErrors(context.Background(), 3), values, validation.Each(validation.Required))
+	if assert.IsType(t, validation.Errors{}, err) {
+		errs := err.(validation.Errors)
+		assert.Len(t, errs, 4)
+		assert.Contains(t, errs, "2")
+		if assert.Contains(t, errs, validation.TruncatedErrorKey) {
+			e := errs[validation.TruncatedErrorKey].(validation.Error)
+			assert.Equal(t, "validation_truncated", e.Code())
+			assert.Equal(t, map[string]interface{}{"skipped": 997, "max": 3}, e.Params())
+		}
+		assert.Equal(t, "0: cannot be blank; 1: cannot be blank; 2: cannot be blank; _truncated: 997 more values were not validated after reaching the maximum of 3 errors.", err.Error())
+	}
+
+	// without a budget all errors are reported
+	err = validation.ValidateWithContext(context.Background(), values, validation.Each(validation.Required))
+	assert.Len(t, err, 1000)
+
+	// a maximum that is not positive removes the limit
+	err = validation.ValidateWithContext(validation.WithMaxErrors(context.Background(), 0), values, validation.Each(validation.Required))
+	assert.Len(t, err, 1000)
+	err = validation.ValidateWithContext(validation.WithMaxErrors(context.Background(), -1), values, validation.Each(validation.Required))
+	assert.Len(t, err, 1000)
+
+	// a nil context is allowed
+	ctx := validation.WithMaxErrors(nil, 3)
+	err = validation.ValidateWithContext(ctx, values, validation.Each(validation.Required))
+	assert.Len(t, err, 4)
+}
+
+func TestWithMaxErrorsNested(t *testing.T) {
+	m1 := Model1{A: "abc"}
+	models := []Model1{m1, m1, m1}
+
+	// each element has 3 errors
+	ctx := validation.WithMaxErrors(context.Background(), 3)
+	err := validation.ValidateWithContext(ctx, models, validation.Each(validation.By(func(value interface{}) error {
+		m := value.(Model1)
+		return validation.ValidateStructWithContext(ctx, &m,
+			validation.Field(&m.A, validation.Length(5, 10)),
+			validation.Field(&m.B, validation.Required),
+			validation.Field(&m.G, validation.Required),
+		)
+	})))
+	assert.EqualError(t, err, "0: (A: the length must be between 5 and 10; B: cannot be blank; g: cannot be blank.); _truncated: 2 more values were not validated after reaching the maximum of 3 errors.")
+
+	// Validatable elements that do not see the context are charged with all the errors they return
+	ctx = validation.WithMaxErrors(context.Background(), 2)
+	err = validation.ValidateWithContext(ctx, []BudgetItem{{}, {}, {}})
+	if assert.IsType(t, validation.Errors{}, err) {
+		errs := err.(validation.Errors)
+		assert.Len(t, errs, 2)
+		assert.Contains(t, errs, "0")
+	}
+}
+
+func TestWithMaxErrorsStruct(t *testing.T) {
+	m := Model1{}
+	err := validation.ValidateStructWithContext(validation.WithMaxErrors(context.Background(), 1), &m,
+		validation.Field(&m.A, validation.Required),
+		validation.Field(&m.B, validation.Required),
+		validation.Field(&m.G, validation.Required),
+	)
+	assert.EqualError(t, err, "A: cannot be blank; _truncated: 2 more values were not validated after reaching the maximum of 1 errors.")
+}
+
+func TestWithMaxErrorsStructRule(t *testing.T) {
+	rule := validation.Struct(
+		validation.F(func(m *Model1) *string { return &m.A }, validation.Required),
+		validation.F(func(m *Model1) *string { return &m.B }, validation.Required),
+		validation.F(func(m *Model1) *string { return &m.G }, validation.Required),
+	)
+	err := validation.ValidateWithContext(validation.WithMaxErrors(context.Background(), 1), Model1{}, rule)
+	assert.EqualError(t, err, "A: cannot be blank; _truncated: 2 more values were not validated after reaching the maximum of 1 errors.")
+
+	err = validation.ValidateWithContext(context.Background(), Model1{}, rule)
+	assert.Len(t, err, 3)
+}
+
+func TestWithMaxErrorsMap(t *testing.T) {
+	ctx := validation.WithMaxErrors(context.Background(), 1)
+	err := validation.ValidateWithContext(ctx, map[string]interface{}{"a": "", "b": ""},
+		validation.Map(
+			validation.Key("a", validation.Required),
+			validation.Key("b", validation.Required),
+		),
+	)
+	assert.EqualError(t, err, "_truncated: 1 more values were not validated after reaching the maximum of 1 errors; a: cannot be blank.")
+}
+
+type BudgetItem struct {
+	A, B string
+}
+
+func (i BudgetItem) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.A, validation.Required),
+		validation.Field(&i.B, validation.Required),
+	)
+}
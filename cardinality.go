@@ -0,0 +1,171 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+const fieldListTemplate = "{{range $i, $f := .fields}}{{if $i}}, {{end}}{{$f}}{{end}}"
+
+var (
+	// ErrExactlyOneOf is the error that returns when not exactly one of a group of fields is provided.
+	ErrExactlyOneOf = NewError("validation_exactly_one_of", "exactly one of "+fieldListTemplate+" must be provided")
+	// ErrAtMostOneOf is the error that returns when more than one of a group of fields is provided.
+	ErrAtMostOneOf = NewError("validation_at_most_one_of", "at most one of "+fieldListTemplate+" can be provided")
+	// ErrAtLeastOneOf is the error that returns when none of a group of fields is provided.
+	ErrAtLeastOneOf = NewError("validation_at_least_one_of", "at least one of "+fieldListTemplate+" must be provided")
+)
+
+// cardinalityRule checks how many values of a group of struct fields or map keys are provided.
+// A value is considered provided if it is neither nil nor empty.
+type cardinalityRule struct {
+	min, max int // max < 0 means no upper limit
+	err      Error
+}
+
+var (
+	exactlyOne = cardinalityRule{min: 1, max: 1, err: ErrExactlyOneOf}
+	atMostOne  = cardinalityRule{min: 0, max: 1, err: ErrAtMostOneOf}
+	atLeastOne = cardinalityRule{min: 1, max: -1, err: ErrAtLeastOneOf}
+)
+
+// ExactlyOneOf returns a struct-level rule for ValidateStruct that checks if exactly one of the given fields is provided.
+// The fields must be specified as pointers to them. A field is considered provided if it is neither nil nor empty.
+// If the check fails, an error listing the names of all the fields in the "fields" parameter is reported for
+// each provided field, or for every field if none is provided. For example,
+//
+//	err := validation.ValidateStruct(&p,
+//	    validation.ExactlyOneOf(&p.Card, &p.BankAccount, &p.Wallet),
+//	)
+//
+// Use MapRule.ExactlyOneOf to check map keys in the same way.
+func ExactlyOneOf(fieldPtrs ...interface{}) *FieldRules {
+	return exactlyOne.fields(fieldPtrs)
+}
+
+// AtMostOneOf returns a struct-level rule for ValidateStruct that checks if at most one of the given fields is provided.
+// Please refer to ExactlyOneOf for how the fields are specified and how the errors are reported.
+func AtMostOneOf(fieldPtrs ...interface{}) *FieldRules {
+	return atMostOne.fields(fieldPtrs)
+}
+
+// AtLeastOneOf returns a struct-level rule for ValidateStruct that checks if at least one of the given fields is provided.
+// Please refer to ExactlyOneOf for how the fields are specified and how the errors are reported.
+func AtLeastOneOf(fieldPtrs ...interface{}) *FieldRules {
+	return atLeastOne.fields(fieldPtrs)
+}
+
+// ExactlyOneOf configures the rule to check if exactly one of the given keys is present with a value that is not empty.
+// If the check fails, an error listing all the keys in the "fields" parameter is reported for each provided key,
+// or for every key if none is provided. The keys should also be declared by Key() unless extra keys are allowed.
+func (r MapRule) ExactlyOneOf(keys ...interface{}) MapRule {
+	return r.withGroup(exactlyOne, keys)
+}
+
+// AtMostOneOf configures the rule to check if at most one of the given keys is present with a value that is not empty.
+// Please refer to MapRule.ExactlyOneOf for how the errors are reported.
+func (r MapRule) AtMostOneOf(keys ...interface{}) MapRule {
+	return r.withGroup(atMostOne, keys)
+}
+
+// AtLeastOneOf configures the rule to check if at least one of the given keys is present with a value that is not empty.
+// Please refer to MapRule.ExactlyOneOf for how the errors are reported.
+func (r MapRule) AtLeastOneOf(keys ...interface{}) MapRule {
+	return r.withGroup(atLeastOne, keys)
+}
+
+func (r MapRule) withGroup(c cardinalityRule, keys []interface{}) MapRule {
+	groups := make([]keyGroup, len(r.groups), len(r.groups)+1)
+	copy(groups, r.groups)
+	r.groups = append(groups, keyGroup{rule: c, keys: keys})
+	return r
+}
+
+// fields returns a struct-level rule applying the cardinality check to the given struct fields.
+func (c cardinalityRule) fields(fieldPtrs []interface{}) *FieldRules {
+	return StructLevel(func(ctx context.Context, structPtr interface{}) error {
+		value := reflect.ValueOf(structPtr).Elem()
+		names := make([]string, len(fieldPtrs))
+		present := make([]bool, len(fieldPtrs))
+		for i, fieldPtr := range fieldPtrs {
+			var ft *reflect.StructField
+			fv := reflect.ValueOf(fieldPtr)
+			if fv.Kind() == reflect.Ptr {
+				ft = FindStructField(value, fv)
+			}
+			if ft == nil {
+				// let ValidateStruct report the invalid field as an internal error
+				return FieldError(fieldPtr, nil)
+			}
+			names[i] = GetErrorFieldNameWithContext(ctx, ft)
+			present[i] = isProvided(fv.Elem().Interface())
+		}
+		err := c.check(names, present)
+		if err == nil {
+			return nil
+		}
+		var errs []error
+		for i, fieldPtr := range fieldPtrs {
+			if present[i] || c.count(present) == 0 {
+				errs = append(errs, FieldError(fieldPtr, err))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// check returns the error to report if the number of provided values is not allowed.
+func (c cardinalityRule) check(names []string, present []bool) Error {
+	n := c.count(present)
+	if n >= c.min && (c.max < 0 || n <= c.max) {
+		return nil
+	}
+	return c.err.SetParams(map[string]interface{}{"fields": names})
+}
+
+func (c cardinalityRule) count(present []bool) int {
+	n := 0
+	for _, p := range present {
+		if p {
+			n++
+		}
+	}
+	return n
+}
+
+// keyGroup is a cardinality check applied to a group of map keys.
+type keyGroup struct {
+	rule cardinalityRule
+	keys []interface{}
+}
+
+// validate applies the cardinality check to the map value and records the errors in errs.
+func (g keyGroup) validate(value reflect.Value, errs Errors) {
+	kt := value.Type().Key()
+	names := make([]string, len(g.keys))
+	present := make([]bool, len(g.keys))
+	for i, key := range g.keys {
+		names[i] = getErrorKeyName(key)
+		if kv := reflect.ValueOf(key); kv.IsValid() && kv.Type().AssignableTo(kt) {
+			if vv := value.MapIndex(kv); vv.IsValid() {
+				present[i] = isProvided(vv.Interface())
+			}
+		}
+	}
+	err := g.rule.check(names, present)
+	if err == nil {
+		return
+	}
+	for i, name := range names {
+		if present[i] || g.rule.count(present) == 0 {
+			addError(errs, name, err)
+		}
+	}
+}
+
+// isProvided checks if a value is neither nil nor empty.
+func isProvided(value interface{}) bool {
+	value, isNil := Indirect(value)
+	return !isNil && !IsEmpty(value)
+}
@@ -0,0 +1,111 @@
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type Payment struct {
+	Card        string `json:"card"`
+	BankAccount *string
+	Wallet      []string `json:"wallet"`
+	Amount      int
+}
+
+func TestCardinalityStruct(t *testing.T) {
+	empty, bank := "", "DE00"
+	p1 := Payment{Card: "4111"}
+	p2 := Payment{Card: "4111", BankAccount: &bank}
+	p3 := Payment{BankAccount: &empty, Wallet: []string{}}
+	p4 := Payment{Card: "4111", BankAccount: &bank, Wallet: []string{"x"}}
+
+	tests := []struct {
+		tag   string
+		model *Payment
+		rules func(p *Payment) []*validation.FieldRules
+		err   string
+	}{
+		{"t1.1", &p1, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.ExactlyOneOf(&p.Card, &p.BankAccount, &p.Wallet)}
+		}, ""},
+		{"t1.2", &p2, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.ExactlyOneOf(&p.Card, &p.BankAccount, &p.Wallet)}
+		}, "BankAccount: exactly one of card, BankAccount, wallet must be provided; card: exactly one of card, BankAccount, wallet must be provided."},
+		{"t1.3", &p3, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.ExactlyOneOf(&p.Card, &p.BankAccount)}
+		}, "BankAccount: exactly one of card, BankAccount must be provided; card: exactly one of card, BankAccount must be provided."},
+		{"t2.1", &p1, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.AtMostOneOf(&p.Card, &p.BankAccount, &p.Wallet)}
+		}, ""},
+		{"t2.2", &p3, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.AtMostOneOf(&p.Card, &p.BankAccount, &p.Wallet)}
+		}, ""},
+		{"t2.3", &p4, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.AtMostOneOf(&p.Card, &p.Wallet)}
+		}, "card: at most one of card, wallet can be provided; wallet: at most one of card, wallet can be provided."},
+		{"t3.1", &p4, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.AtLeastOneOf(&p.Card, &p.BankAccount, &p.Wallet)}
+		}, ""},
+		{"t3.2", &p3, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.AtLeastOneOf(&p.Card, &p.Wallet)}
+		}, "card: at least one of card, wallet must be provided; wallet: at least one of card, wallet must be provided."},
+		// field rules take precedence
+		{"t4.1", &p2, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.AtMostOneOf(&p.Card, &p.BankAccount),
+				validation.Field(&p.Card, validation.Length(5, 10)),
+			}
+		}, "BankAccount: at most one of card, BankAccount can be provided; card: the length must be between 5 and 10."},
+		// invalid fields
+		{"t5.1", &p1, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.ExactlyOneOf(&p.Card, &p2.Card)}
+		}, "field #0 cannot be found in the struct"},
+		{"t5.2", &p1, func(p *Payment) []*validation.FieldRules {
+			return []*validation.FieldRules{validation.Field(&p.Card), validation.ExactlyOneOf(&p.Card, p.Card)}
+		}, "field #1 must be specified as a pointer"},
+	}
+
+	for _, test := range tests {
+		err := validation.ValidateStruct(test.model, test.rules(test.model)...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.ValidateStructWithContext(context.Background(), &p2, validation.ExactlyOneOf(&p2.Card, &p2.BankAccount))
+	if assert.IsType(t, validation.Errors{}, err) {
+		e := err.(validation.Errors)["card"].(validation.Error)
+		assert.Equal(t, "validation_exactly_one_of", e.Code())
+		assert.Equal(t, []string{"card", "BankAccount"}, e.Params()["fields"])
+	}
+}
+
+func TestCardinalityMap(t *testing.T) {
+	rule := validation.Map(
+		validation.Key("email").Optional(),
+		validation.Key("phone").Optional(),
+		validation.Key("card").Optional(),
+		validation.Key("bank").Optional(),
+	).AtLeastOneOf("email", "phone").ExactlyOneOf("card", "bank")
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]interface{}{"email": "a@b.c", "card": "4111"}, ""},
+		{"t2", map[string]interface{}{"phone": "123", "email": "", "bank": "DE00"}, ""},
+		{"t3", map[string]interface{}{"email": "", "card": "4111", "bank": "DE00"}, "bank: exactly one of card, bank must be provided; card: exactly one of card, bank must be provided; email: at least one of email, phone must be provided; phone: at least one of email, phone must be provided."},
+		{"t4", map[string]string{"phone": "123"}, "bank: exactly one of card, bank must be provided; card: exactly one of card, bank must be provided."},
+		{"t5", map[int]string{1: "a"}, "1: key not expected; bank: key not the correct type; card: key not the correct type; email: key not the correct type; phone: key not the correct type."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	rule = validation.Map().AllowExtraKeys().AtMostOneOf("card", "bank")
+	assert.NoError(t, validation.Validate(map[string]string{"card": "4111", "bank": ""}, rule))
+	assert.EqualError(t, validation.Validate(map[string]string{"card": "4111", "bank": "DE00"}, rule), "bank: at most one of card, bank can be provided; card: at most one of card, bank can be provided.")
+}
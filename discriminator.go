@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+	"sort"
+)
+
+// ErrDiscriminatorUnknown is the error that returns when the value of a discriminator is not among the registered ones.
+var ErrDiscriminatorUnknown = NewError("validation_discriminator_unknown", "must be one of {{range $i, $v := .allowed}}{{if $i}}, {{end}}{{$v}}{{end}}")
+
+// DiscriminatorRule is a validation rule that validates a map with the rule registered for the value of one of its keys.
+type DiscriminatorRule struct {
+	key   interface{}
+	cases map[interface{}]Rule
+	err   Error
+}
+
+// Discriminator returns a validation rule that validates polymorphic maps. It reads the value of the given key
+// and validates the whole map with the rule registered for that value in cases. For example,
+//
+//	validation.Discriminator("type", map[interface{}]validation.Rule{
+//	    "card": validation.Map(validation.Key("type"), validation.Key("number", validation.Required)),
+//	    "bank": validation.Map(validation.Key("type"), validation.Key("iban", validation.Required)),
+//	})
+//
+// If the key is missing, ErrKeyMissing is reported for it. If its value is not registered, ErrDiscriminatorUnknown
+// is reported for it with the registered values in the "allowed" parameter.
+// A nil map is considered valid.
+func Discriminator(key interface{}, cases map[interface{}]Rule) DiscriminatorRule {
+	return DiscriminatorRule{key: key, cases: cases, err: ErrDiscriminatorUnknown}
+}
+
+// Error sets the error message for an unknown discriminator value.
+func (r DiscriminatorRule) Error(message string) DiscriminatorRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for an unknown discriminator value.
+func (r DiscriminatorRule) ErrorObject(err Error) DiscriminatorRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r DiscriminatorRule) Validate(m interface{}) error {
+	return r.ValidateWithContext(context.TODO(), m)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+func (r DiscriminatorRule) ValidateWithContext(ctx context.Context, m interface{}) error {
+	value := reflect.ValueOf(m)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Map {
+		// must be a map
+		return NewInternalError(ErrNotMap)
+	}
+	if value.IsNil() {
+		// treat a nil map as valid
+		return nil
+	}
+
+	name := getErrorKeyName(r.key)
+	kv := reflect.ValueOf(r.key)
+	if !kv.IsValid() || !kv.Type().AssignableTo(value.Type().Key()) {
+		return Errors{name: ErrKeyWrongType}
+	}
+	vv := value.MapIndex(kv)
+	if !vv.IsValid() {
+		return Errors{name: ErrKeyMissing}
+	}
+	rule, ok := lookupCase(r.cases, vv.Interface())
+	if !ok {
+		return Errors{name: unknownDiscriminator(r.err, r.cases)}
+	}
+	if ctx == nil {
+		return Validate(m, rule)
+	}
+	return ValidateWithContext(ctx, m, rule)
+}
+
+// DiscriminatorField returns a struct-level rule for ValidateStruct that validates polymorphic structs.
+// It reads the value of the given field and validates the struct with the field rules registered for that value
+// in cases. The field must be specified as a pointer to it. For example,
+//
+//	err := validation.ValidateStruct(&p,
+//	    validation.Field(&p.Type, validation.Required),
+//	    validation.DiscriminatorField(&p.Type, map[interface{}][]*validation.FieldRules{
+//	        "card": {validation.Field(&p.Number, validation.Required)},
+//	        "bank": {validation.Field(&p.IBAN, validation.Required)},
+//	    }),
+//	)
+//
+// If the value of the field is not registered, ErrDiscriminatorUnknown is reported for the field
+// with the registered values in the "allowed" parameter.
+func DiscriminatorField(fieldPtr interface{}, cases map[interface{}][]*FieldRules) *FieldRules {
+	return StructLevel(func(ctx context.Context, structPtr interface{}) error {
+		fv := reflect.ValueOf(fieldPtr)
+		if fv.Kind() != reflect.Ptr || fv.IsNil() {
+			// let ValidateStruct report the invalid field as an internal error
+			return FieldError(fieldPtr, nil)
+		}
+		fields, ok := lookupCase(cases, fv.Elem().Interface())
+		if !ok {
+			return FieldError(fieldPtr, unknownDiscriminator(ErrDiscriminatorUnknown, cases))
+		}
+		return ValidateStructWithContext(ctx, structPtr, fields...)
+	})
+}
+
+// lookupCase returns the case registered for the given discriminator value.
+// Pointers are looked up by the values they refer to.
+func lookupCase[T any](cases map[interface{}]T, value interface{}) (T, bool) {
+	var c T
+	value, isNil := Indirect(value)
+	if isNil || !reflect.TypeOf(value).Comparable() {
+		return c, false
+	}
+	c, ok := cases[value]
+	return c, ok
+}
+
+// unknownDiscriminator returns the error for an unknown discriminator value, listing the registered values.
+func unknownDiscriminator[T any](err Error, cases map[interface{}]T) Error {
+	allowed := make([]string, 0, len(cases))
+	for value := range cases {
+		allowed = append(allowed, getErrorKeyName(value))
+	}
+	sort.Strings(allowed)
+	return err.SetParams(map[string]interface{}{"allowed": allowed})
+}
@@ -0,0 +1,89 @@
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type PaymentMethod struct {
+	Type   string `json:"type"`
+	Number string `json:"number"`
+	IBAN   string `json:"iban"`
+}
+
+func TestDiscriminator(t *testing.T) {
+	rule := validation.Discriminator("type", map[interface{}]validation.Rule{
+		"card": validation.Map(validation.Key("type"), validation.Key("number", validation.Required)),
+		"bank": validation.Map(validation.Key("type"), validation.Key("iban", validation.Required)),
+	})
+	typ := "card"
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1.1", map[string]interface{}{"type": "card", "number": "4111"}, ""},
+		{"t1.2", map[string]interface{}{"type": "bank", "iban": "DE00"}, ""},
+		{"t1.3", map[string]interface{}{"type": &typ, "number": "4111"}, ""},
+		{"t1.4", map[string]interface{}{"type": "card", "iban": "DE00"}, "iban: key not expected; number: required key is missing."},
+		{"t1.5", map[string]interface{}{"type": "wallet"}, "type: must be one of bank, card."},
+		{"t1.6", map[string]interface{}{"type": []string{"card"}}, "type: must be one of bank, card."},
+		{"t1.7", map[string]interface{}{"type": nil}, "type: must be one of bank, card."},
+		{"t1.8", map[string]interface{}{"number": "4111"}, "type: required key is missing."},
+		{"t1.9", map[int]interface{}{1: "card"}, "type: key not the correct type."},
+		{"t1.10", map[string]interface{}(nil), ""},
+		{"t1.11", "abc", "only a map can be validated"},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateWithContext(context.Background(), test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.Validate(map[string]string{"type": "x"}, rule.Error("unsupported type"))
+	assert.EqualError(t, err, "type: unsupported type.")
+	err = validation.Validate(map[string]string{"type": "x"}, rule)
+	if assert.IsType(t, validation.Errors{}, err) {
+		e := err.(validation.Errors)["type"].(validation.Error)
+		assert.Equal(t, "validation_discriminator_unknown", e.Code())
+		assert.Equal(t, []string{"bank", "card"}, e.Params()["allowed"])
+	}
+}
+
+func TestDiscriminatorField(t *testing.T) {
+	rules := func(p *PaymentMethod) []*validation.FieldRules {
+		return []*validation.FieldRules{
+			validation.Field(&p.Type, validation.Required),
+			validation.DiscriminatorField(&p.Type, map[interface{}][]*validation.FieldRules{
+				"card": {validation.Field(&p.Number, validation.Required), validation.Field(&p.IBAN, validation.Empty)},
+				"bank": {validation.Field(&p.IBAN, validation.Required)},
+			}),
+		}
+	}
+
+	tests := []struct {
+		tag   string
+		value PaymentMethod
+		err   string
+	}{
+		{"t1", PaymentMethod{Type: "card", Number: "4111"}, ""},
+		{"t2", PaymentMethod{Type: "card", IBAN: "DE00"}, "iban: must be blank; number: cannot be blank."},
+		{"t3", PaymentMethod{Type: "bank"}, "iban: cannot be blank."},
+		{"t4", PaymentMethod{Type: "wallet"}, "type: must be one of bank, card."},
+		{"t5", PaymentMethod{}, "type: cannot be blank."},
+	}
+	for _, test := range tests {
+		p := test.value
+		err := validation.ValidateStruct(&p, rules(&p)...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	p := PaymentMethod{}
+	err := validation.ValidateStruct(&p, validation.DiscriminatorField(p.Type, nil))
+	assert.EqualError(t, err, "field #0 must be specified as a pointer")
+}
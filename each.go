@@ -16,9 +16,27 @@ func Each(rules ...Rule) EachRule {
 	}
 }
 
+// EachKey returns a validation rule that loops through a map and validates each key inside with the provided rules.
+// An error found for a key is reported under the name of the key. Use EachRule.Keys to validate both keys and values.
+// An empty map is considered valid.
+func EachKey(rules ...Rule) EachRule {
+	return EachRule{
+		keyRules: rules,
+	}
+}
+
 // EachRule is a validation rule that validates elements in a map/slice/array using the specified list of rules.
 type EachRule struct {
-	rules []Rule
+	rules    []Rule
+	keyRules []Rule
+}
+
+// Keys configures the rule to also validate each key of a map with the provided rules.
+// The keys are validated before the values, and the value of a key that fails validation is not validated.
+// The key rules are ignored when validating slices and arrays.
+func (r EachRule) Keys(rules ...Rule) EachRule {
+	r.keyRules = rules
+	return r
 }
 
 // Validate loops through the given iterable and calls the Ozzo Validate() method for each value.
@@ -28,44 +46,47 @@ func (r EachRule) Validate(value interface{}) error {
 
 // ValidateWithContext loops through the given iterable and calls the Ozzo ValidateWithContext() method for each value.
 func (r EachRule) ValidateWithContext(ctx context.Context, value interface{}) error {
-	errs := Errors{}
+	c := newErrorCollector(ctx, Errors{})
 
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
 	case reflect.Map:
-		for _, k := range v.MapKeys() {
-			val := r.getInterface(v.MapIndex(k))
+		keys := v.MapKeys()
+		for i, k := range keys {
+			if c.stop(len(keys) - i) {
+				break
+			}
+			mark := c.mark()
 			var err error
-			if ctx == nil {
-				err = Validate(val, r.rules...)
-			} else {
-				err = ValidateWithContext(ctx, val, r.rules...)
+			if len(r.keyRules) > 0 {
+				err = r.validate(ctx, r.getInterface(k), r.keyRules)
 			}
-			if err != nil {
-				errs[r.getString(k)] = err
+			if err == nil {
+				err = r.validate(ctx, r.getInterface(v.MapIndex(k)), r.rules)
 			}
+			c.add(r.getString(k), err, mark)
 		}
 	case reflect.Slice, reflect.Array:
-		for i := 0; i < v.Len(); i++ {
-			val := r.getInterface(v.Index(i))
-			var err error
-			if ctx == nil {
-				err = Validate(val, r.rules...)
-			} else {
-				err = ValidateWithContext(ctx, val, r.rules...)
-			}
-			if err != nil {
-				errs[strconv.Itoa(i)] = err
+		l := v.Len()
+		for i := 0; i < l; i++ {
+			if c.stop(l - i) {
+				break
 			}
+			mark := c.mark()
+			c.add(strconv.Itoa(i), r.validate(ctx, r.getInterface(v.Index(i)), r.rules), mark)
 		}
 	default:
 		return errors.New("must be an iterable (map, slice or array)")
 	}
 
-	if len(errs) > 0 {
-		return errs
+	return c.result()
+}
+
+func (r EachRule) validate(ctx context.Context, value interface{}, rules []Rule) error {
+	if ctx == nil {
+		return Validate(value, rules...)
 	}
-	return nil
+	return ValidateWithContext(ctx, value, rules...)
 }
 
 func (r EachRule) getInterface(value reflect.Value) interface{} {
@@ -80,14 +101,16 @@ func (r EachRule) getInterface(value reflect.Value) interface{} {
 	}
 }
 
+// getString returns the name used to report the error of a map key, formatting it as fmt does with %v.
+// Pointers and interfaces are formatted by the value they refer to, and nil ones by an empty string.
 func (r EachRule) getString(value reflect.Value) string {
 	switch value.Kind() {
 	case reflect.Ptr, reflect.Interface:
 		if value.IsNil() {
 			return ""
 		}
-		return value.Elem().String()
+		return r.getString(value.Elem())
 	default:
-		return value.String()
+		return getErrorKeyName(value.Interface())
 	}
 }
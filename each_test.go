@@ -78,3 +78,47 @@ func TestEachWithContext(t *testing.T) {
 		assertError(t, test.err, err, test.tag)
 	}
 }
+
+func TestEachKeyNames(t *testing.T) {
+	type point struct{ X, Y int }
+	one := 1
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[int]string{1: "", 22: "a"}, "1: cannot be blank."},
+		{"t2", map[uint8]string{7: ""}, "7: cannot be blank."},
+		{"t3", map[point]string{{1, 2}: ""}, "{1 2}: cannot be blank."},
+		{"t4", map[*int]string{&one: ""}, "1: cannot be blank."},
+		{"t5", map[interface{}]string{3.5: "", true: ""}, "3.5: cannot be blank; true: cannot be blank."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, validation.Each(validation.Required))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestEachKey(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  validation.Rule
+		err   string
+	}{
+		{"t1.1", map[string]string{"abc": "", "xyz": ""}, validation.EachKey(validation.Length(3, 3)), ""},
+		{"t1.2", map[string]string{"ab": "", "xyz": ""}, validation.EachKey(validation.Length(3, 3)), "ab: the length must be exactly 3."},
+		{"t1.3", map[int]int{5: 1, 50: 1}, validation.EachKey(validation.Max(10)), "50: must be no greater than 10."},
+		{"t1.4", []string{"", "a"}, validation.EachKey(validation.Length(3, 3)), ""},
+		{"t1.5", 123, validation.EachKey(validation.Length(3, 3)), "must be an iterable (map, slice or array)"},
+		// keys are validated before values
+		{"t2.1", map[string]string{"ab": "", "xyz": "", "abc": "a"}, validation.Each(validation.Required).Keys(validation.Length(3, 3)), "ab: the length must be exactly 3; xyz: cannot be blank."},
+		{"t2.2", []string{"", "a"}, validation.Each(validation.Required).Keys(validation.Length(3, 3)), "0: cannot be blank."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateWithContext(context.Background(), test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+}
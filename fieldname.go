@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+type (
+	// NameMapper converts the Go name of a struct field into the name used in validation errors.
+	NameMapper func(name string) string
+
+	// FieldNameOptions configures how the error name of a struct field is resolved.
+	// The tags are looked up in the listed order and the first one carrying a name wins.
+	// If none of them does, the Go field name is used, transformed by Mapper if it is set.
+	FieldNameOptions struct {
+		// Tags lists the struct tag names to look up, e.g. []string{"form", "json"}.
+		Tags []string
+		// Mapper converts the Go field name when none of the tags supplies a name.
+		Mapper NameMapper
+	}
+
+	fieldNameOptionsKey struct{}
+)
+
+// WithFieldNameOptions returns a copy of ctx carrying the given field name options.
+// ValidateStructWithContext uses them instead of ErrorTag when naming the fields in validation errors,
+// which allows different callers to use different struct tags without touching the global ErrorTag.
+// For example,
+//
+//	ctx := validation.WithFieldNameOptions(ctx, validation.FieldNameOptions{
+//	    Tags:   []string{"form", "json"},
+//	    Mapper: validation.SnakeCase,
+//	})
+//	err := validation.ValidateStructWithContext(ctx, &form, ...)
+func WithFieldNameOptions(ctx context.Context, opts FieldNameOptions) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, fieldNameOptionsKey{}, opts)
+}
+
+// FieldNameOptionsFromContext returns the field name options carried by ctx.
+// If ctx carries none, the options built from ErrorTag are returned.
+func FieldNameOptionsFromContext(ctx context.Context) FieldNameOptions {
+	if ctx != nil {
+		if opts, ok := ctx.Value(fieldNameOptionsKey{}).(FieldNameOptions); ok {
+			return opts
+		}
+	}
+	return FieldNameOptions{Tags: []string{ErrorTag}}
+}
+
+// ErrorFieldName returns the name that should be used to represent the validation error of a struct field.
+func (o FieldNameOptions) ErrorFieldName(f *reflect.StructField) string {
+	for _, name := range o.Tags {
+		if tag := f.Tag.Get(name); tag != "" && tag != "-" {
+			if cps := strings.SplitN(tag, ",", 2); cps[0] != "" {
+				return cps[0]
+			}
+		}
+	}
+	if o.Mapper != nil {
+		return o.Mapper(f.Name)
+	}
+	return f.Name
+}
+
+// GetErrorFieldNameWithContext returns the name that should be used to represent the validation error
+// of a struct field according to the field name options carried by ctx.
+func GetErrorFieldNameWithContext(ctx context.Context, f *reflect.StructField) string {
+	return FieldNameOptionsFromContext(ctx).ErrorFieldName(f)
+}
+
+// SnakeCase is a NameMapper that converts a Go name into snake case, e.g. "HTTPStatusCode" into "http_status_code".
+func SnakeCase(name string) string {
+	return strings.Join(splitWords(name), "_")
+}
+
+// CamelCase is a NameMapper that converts a Go name into lower camel case, e.g. "HTTPStatusCode" into "httpStatusCode".
+func CamelCase(name string) string {
+	var s strings.Builder
+	for i, w := range splitWords(name) {
+		if i > 0 {
+			rs := []rune(w)
+			rs[0] = unicode.ToUpper(rs[0])
+			w = string(rs)
+		}
+		s.WriteString(w)
+	}
+	return s.String()
+}
+
+// splitWords splits a Go name into lower-cased words, keeping acronyms such as "HTTP" together.
+func splitWords(name string) []string {
+	var words []string
+	rs := []rune(name)
+	start := 0
+	flush := func(end int) {
+		if w := strings.Trim(string(rs[start:end]), "_"); w != "" {
+			words = append(words, strings.ToLower(w))
+		}
+		start = end
+	}
+	for i := 1; i < len(rs); i++ {
+		prev, cur := rs[i-1], rs[i]
+		switch {
+		case cur == '_' || prev == '_':
+			flush(i)
+		case unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			// "userID" => "user", "ID"
+			flush(i)
+		case unicode.IsUpper(prev) && unicode.IsUpper(cur) && i+1 < len(rs) && unicode.IsLower(rs[i+1]):
+			// "HTTPStatus" => "HTTP", "Status"
+			flush(i)
+		}
+	}
+	flush(len(rs))
+	return words
+}
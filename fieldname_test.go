@@ -0,0 +1,100 @@
+package validation_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type FormModel struct {
+	FormModelBase
+	UserName  string `form:"user" json:"username"`
+	Email     string `json:"email_address"`
+	HTTPCode  int
+	Ignored   string `form:"-" json:"-"`
+	PostCode2 string
+}
+
+type FormModelBase struct {
+	AccountID string
+}
+
+func (m FormModelBase) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.AccountID, validation.Required),
+	)
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		name, snake, camel string
+	}{
+		{"", "", ""},
+		{"Name", "name", "name"},
+		{"UserName", "user_name", "userName"},
+		{"HTTPStatusCode", "http_status_code", "httpStatusCode"},
+		{"UserID", "user_id", "userId"},
+		{"Address2Line", "address2_line", "address2Line"},
+		{"already_snake", "already_snake", "alreadySnake"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.snake, validation.SnakeCase(test.name), test.name)
+		assert.Equal(t, test.camel, validation.CamelCase(test.name), test.name)
+	}
+}
+
+func TestFieldNameOptions(t *testing.T) {
+	var m FormModel
+	v := reflect.ValueOf(&m).Elem()
+	user := validation.FindStructField(v, reflect.ValueOf(&m.UserName))
+	email := validation.FindStructField(v, reflect.ValueOf(&m.Email))
+	code := validation.FindStructField(v, reflect.ValueOf(&m.HTTPCode))
+	ignored := validation.FindStructField(v, reflect.ValueOf(&m.Ignored))
+
+	opts := validation.FieldNameOptions{Tags: []string{"form", "json"}, Mapper: validation.SnakeCase}
+	assert.Equal(t, "user", opts.ErrorFieldName(user))
+	assert.Equal(t, "email_address", opts.ErrorFieldName(email))
+	assert.Equal(t, "http_code", opts.ErrorFieldName(code))
+	assert.Equal(t, "ignored", opts.ErrorFieldName(ignored))
+
+	assert.Equal(t, "username", validation.GetErrorFieldName(user))
+	assert.Equal(t, "HTTPCode", validation.GetErrorFieldName(code))
+	assert.Equal(t, "username", validation.GetErrorFieldNameWithContext(context.Background(), user))
+
+	ctx := validation.WithFieldNameOptions(context.Background(), opts)
+	assert.Equal(t, "user", validation.GetErrorFieldNameWithContext(ctx, user))
+	assert.Equal(t, opts.Tags, validation.FieldNameOptionsFromContext(ctx).Tags)
+	assert.Equal(t, []string{"json"}, validation.FieldNameOptionsFromContext(context.Background()).Tags)
+}
+
+func TestValidateStructWithFieldNameOptions(t *testing.T) {
+	m := FormModel{}
+	rules := func(m *FormModel) []*validation.FieldRules {
+		return []*validation.FieldRules{
+			validation.Field(&m.FormModelBase),
+			validation.Field(&m.UserName, validation.Required),
+			validation.Field(&m.Email, validation.Required),
+			validation.Field(&m.HTTPCode, validation.Required),
+			validation.Field(&m.PostCode2, validation.Required),
+		}
+	}
+
+	err := validation.ValidateStruct(&m, rules(&m)...)
+	assert.EqualError(t, err, "AccountID: cannot be blank; HTTPCode: cannot be blank; PostCode2: cannot be blank; email_address: cannot be blank; username: cannot be blank.")
+
+	form := validation.WithFieldNameOptions(context.Background(), validation.FieldNameOptions{
+		Tags:   []string{"form", "json"},
+		Mapper: validation.SnakeCase,
+	})
+	err = validation.ValidateStructWithContext(form, &m, rules(&m)...)
+	assert.EqualError(t, err, "account_id: cannot be blank; email_address: cannot be blank; http_code: cannot be blank; post_code2: cannot be blank; user: cannot be blank.")
+
+	query := validation.WithFieldNameOptions(context.Background(), validation.FieldNameOptions{
+		Mapper: validation.CamelCase,
+	})
+	err = validation.ValidateStructWithContext(query, &m, rules(&m)...)
+	assert.EqualError(t, err, "accountId: cannot be blank; email: cannot be blank; httpCode: cannot be blank; postCode2: cannot be blank; userName: cannot be blank.")
+}
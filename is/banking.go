@@ -0,0 +1,210 @@
+package is
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is/utils"
+)
+
+var (
+	// ErrIBAN is the error that returns in case of an invalid IBAN.
+	ErrIBAN = validation.NewError("validation_is_iban", "must be a valid IBAN")
+	// ErrBIC is the error that returns in case of an invalid BIC.
+	ErrBIC = validation.NewError("validation_is_bic", "must be a valid BIC")
+	// ErrISIN is the error that returns in case of an invalid ISIN.
+	ErrISIN = validation.NewError("validation_is_isin", "must be a valid ISIN")
+	// ErrCUSIP is the error that returns in case of an invalid CUSIP.
+	ErrCUSIP = validation.NewError("validation_is_cusip", "must be a valid CUSIP")
+	// ErrSEDOL is the error that returns in case of an invalid SEDOL.
+	ErrSEDOL = validation.NewError("validation_is_sedol", "must be a valid SEDOL")
+	// ErrLEI is the error that returns in case of an invalid LEI.
+	ErrLEI = validation.NewError("validation_is_lei", "must be a valid LEI")
+)
+
+var (
+	// IBAN validates if a string is a valid International Bank Account Number (ISO 13616), checking the country
+	// specific length and structure and the mod-97 check digits. Letters must be in upper case, and the groups
+	// of the print format may be separated by spaces, e.g. "DE89 3704 0044 0532 0130 00".
+	IBAN = validation.NewStringRuleWithError(isIBAN, ErrIBAN)
+	// BIC validates if a string is a valid Business Identifier Code (ISO 9362), also known as SWIFT code,
+	// of either 8 or 11 characters
+	BIC = validation.NewStringRuleWithError(isBIC, ErrBIC)
+	// ISIN validates if a string is a valid International Securities Identification Number (ISO 6166)
+	ISIN = validation.NewStringRuleWithError(isISIN, ErrISIN)
+	// CUSIP validates if a string is a valid CUSIP number
+	CUSIP = validation.NewStringRuleWithError(isCUSIP, ErrCUSIP)
+	// SEDOL validates if a string is a valid Stock Exchange Daily Official List number
+	SEDOL = validation.NewStringRuleWithError(isSEDOL, ErrSEDOL)
+	// LEI validates if a string is a valid Legal Entity Identifier (ISO 17442)
+	LEI = validation.NewStringRuleWithError(isLEI, ErrLEI)
+)
+
+var (
+	reBIC   = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`)
+	reISIN  = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+	reCUSIP = regexp.MustCompile(`^[A-Z0-9*@#]{8}[0-9]$`)
+	reSEDOL = regexp.MustCompile(`^[0-9BCDFGHJKLMNPQRSTVWXYZ]{6}[0-9]$`)
+	reLEI   = regexp.MustCompile(`^[A-Z0-9]{18}[0-9]{2}$`)
+
+	// ibanFormats maps the countries using IBANs to the structure of their Basic Bank Account Numbers
+	// as listed in the IBAN registry, where "n" stands for digits, "a" for upper case letters and "c"
+	// for both, e.g. "8n10n" is 8 digits followed by 10 digits.
+	ibanFormats = map[string]string{
+		"AD": "4n4n12c", "AE": "3n16n", "AL": "8n16c", "AT": "5n11n", "AZ": "4a20c", "BA": "3n3n8n2n",
+		"BE": "3n7n2n", "BG": "4a4n2n8c", "BH": "4a14c", "BI": "5n5n11n2n", "BR": "8n5n10n1a1c", "BY": "4c4n16c",
+		"CH": "5n12c", "CR": "4n14n", "CY": "3n5n16c", "CZ": "4n6n10n", "DE": "8n10n", "DJ": "5n5n11n2n",
+		"DK": "4n9n1n", "DO": "4c20n", "EE": "2n2n11n1n", "EG": "4n4n17n", "ES": "4n4n1n1n10n", "FI": "3n11n",
+		"FK": "2a12n", "FO": "4n9n1n", "FR": "5n5n11c2n", "GB": "4a6n8n", "GE": "2a16n", "GI": "4a15c",
+		"GL": "4n9n1n", "GR": "3n4n16c", "GT": "4c20c", "HR": "7n10n", "HU": "3n4n1n15n1n", "IE": "4a6n8n",
+		"IL": "3n3n13n", "IQ": "4a3n12n", "IS": "4n2n6n10n", "IT": "1a5n5n12c", "JO": "4a4n18c", "KW": "4a22c",
+		"KZ": "3n13c", "LB": "4n20c", "LC": "4a24c", "LI": "5n12c", "LT": "5n11n", "LU": "3n13c",
+		"LV": "4a13c", "LY": "3n3n15n", "MC": "5n5n11c2n", "MD": "2c18c", "ME": "3n13n2n", "MK": "3n10c2n",
+		"MN": "4n12n", "MR": "5n5n11n2n", "MT": "4a5n18c", "MU": "4a2n2n12n3n3a", "NI": "4a20n", "NL": "4a10n",
+		"NO": "4n6n1n", "OM": "3n16c", "PK": "4a16c", "PL": "8n16n", "PS": "4a21c", "PT": "4n4n11n2n",
+		"QA": "4a21c", "RO": "4a16c", "RS": "3n13n2n", "RU": "9n5n15c", "SA": "2n18c", "SC": "4a2n2n16n3a",
+		"SD": "2n12n", "SE": "3n16n1n", "SI": "5n8n2n", "SK": "4n6n10n", "SM": "1a5n5n12c", "SO": "4n3n12n",
+		"ST": "4n4n11n2n", "SV": "4a20n", "TL": "3n14n2n", "TN": "2n3n13n2n", "TR": "5n1n16c", "UA": "6n19c",
+		"VA": "3n15n", "VG": "4a16n", "XK": "4n10n2n", "YE": "4a4n18c",
+	}
+	reIBANs = compileIBANFormats(ibanFormats)
+)
+
+func isIBAN(value string) bool {
+	if strings.Contains(value, " ") {
+		// only the print format, with groups of four characters, may contain spaces
+		groups := strings.Split(value, " ")
+		for i, g := range groups {
+			if g == "" || len(g) > 4 || len(g) < 4 && i < len(groups)-1 {
+				return false
+			}
+		}
+		value = strings.Join(groups, "")
+	}
+	if len(value) < 5 || !isCountry(value[:2]) {
+		return false
+	}
+	if re, ok := reIBANs[value[:2]]; !ok || !re.MatchString(value) {
+		return false
+	}
+	// the check digits range from 02 to 98
+	return value[2:4] >= "02" && value[2:4] <= "98" && mod97(value[4:]+value[:4]) == 1
+}
+
+func isBIC(value string) bool {
+	return reBIC.MatchString(value) && isCountry(value[4:6])
+}
+
+func isISIN(value string) bool {
+	if !reISIN.MatchString(value) {
+		return false
+	}
+	switch value[:2] {
+	case "XS", "EU", "XA", "XB", "XC", "XD":
+		// international securities
+	default:
+		if !isCountry(value[:2]) {
+			return false
+		}
+	}
+	var digits strings.Builder
+	for _, c := range value {
+		digits.WriteString(alnumDigits(c))
+	}
+	return luhn(digits.String())
+}
+
+func isCUSIP(value string) bool {
+	if !reCUSIP.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i, c := range value[:8] {
+		v := strings.IndexRune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ*@#", c)
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return (10-sum%10)%10 == int(value[8]-'0')
+}
+
+func isSEDOL(value string) bool {
+	if !reSEDOL.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i, c := range value[:6] {
+		sum += alnumValue(c) * [...]int{1, 3, 1, 7, 3, 9}[i]
+	}
+	return (10-sum%10)%10 == int(value[6]-'0')
+}
+
+func isLEI(value string) bool {
+	return reLEI.MatchString(value) && mod97(value) == 1
+}
+
+// luhn checks if a string of digits satisfies the Luhn algorithm.
+func luhn(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isCountry checks if a string is an ISO 3166 alpha-2 country code or "XK", the user-assigned code used for Kosovo.
+func isCountry(code string) bool {
+	return code == "XK" || utils.IsISO3166Alpha2(code)
+}
+
+// mod97 returns the remainder of the division by 97 of the number obtained by replacing the letters
+// of a string of digits and upper case letters with two digits, i.e. "A" with "10" up to "Z" with "35" (ISO 7064).
+func mod97(value string) int {
+	r := 0
+	for _, c := range value {
+		for _, d := range alnumDigits(c) {
+			r = (r*10 + int(d-'0')) % 97
+		}
+	}
+	return r
+}
+
+// alnumValue returns the value of a digit or upper case letter, where "A" is 10 up to "Z" being 35.
+func alnumValue(c rune) int {
+	if c >= 'A' {
+		return int(c-'A') + 10
+	}
+	return int(c - '0')
+}
+
+// alnumDigits returns the digits of the value of a digit or upper case letter (see alnumValue).
+func alnumDigits(c rune) string {
+	if c >= 'A' {
+		return string([]byte{byte('0' + alnumValue(c)/10), byte('0' + alnumValue(c)%10)})
+	}
+	return string(c)
+}
+
+// compileIBANFormats compiles the given BBAN structures to regular expressions matching the whole IBANs.
+func compileIBANFormats(formats map[string]string) map[string]*regexp.Regexp {
+	classes := map[byte]string{'n': "[0-9]", 'a': "[A-Z]", 'c': "[A-Z0-9]"}
+	res := make(map[string]*regexp.Regexp, len(formats))
+	for country, format := range formats {
+		var sb strings.Builder
+		sb.WriteString("^" + country + "[0-9]{2}")
+		for _, part := range regexp.MustCompile(`[0-9]+[nac]`).FindAllString(format, -1) {
+			sb.WriteString(classes[part[len(part)-1]] + "{" + part[:len(part)-1] + "}")
+		}
+		sb.WriteString("$")
+		res[country] = regexp.MustCompile(sb.String())
+	}
+	return res
+}
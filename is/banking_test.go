@@ -0,0 +1,78 @@
+package is_test
+
+import (
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBankingIdentifiers(t *testing.T) {
+	tests := []struct {
+		tag     string
+		rule    validation.Rule
+		valid   []string
+		invalid []string
+		code    string
+	}{
+		{
+			"IBAN", is.IBAN,
+			[]string{
+				"DE89370400440532013000", "DE89 3704 0044 0532 0130 00", "GB82WEST12345698765432",
+				"FR1420041010050500013M02606", "NL91ABNA0417164300", "BE68539007547034", "CH9300762011623852957",
+				"IT60X0542811101000000123456", "NO9386011117947", "ES9121000418450200051332",
+				"MT84MALT011000012345MTLCAST001S", "BR1800360305000010009795493C1", "XK051212012345678906",
+			},
+			[]string{
+				"DE89370400440532013001", "DE8937040044053201300", "DE893704004405320130000", "de89370400440532013000",
+				"DE89 370400440532013000", "DE89  3704 0044 0532 0130 00", "DE89 3704 0044 0532 0130 00 ",
+				"NL91ABNA04171643OO", "GB82WEST1234569876543A", "US64SVBKUS6S3300958879", "XX89370400440532013000",
+				"DE", "DE00370400440532013000",
+			},
+			"validation_is_iban",
+		},
+		{
+			"BIC", is.BIC,
+			[]string{"DEUTDEFF", "DEUTDEFF500", "NEDSZAJJXXX", "BOFAUS3N", "CHASGB2L"},
+			[]string{"DEUTDEF", "DEUTDEFF5001", "DEUTXXFF", "deutdeff", "DEUT1EFF", "DEUTDEFF50"},
+			"validation_is_bic",
+		},
+		{
+			"ISIN", is.ISIN,
+			[]string{"US0378331005", "AU0000XVGZA3", "GB0002634946", "DE000BAY0017", "XS2021832634"},
+			[]string{"US0378331006", "US037833100", "ZZ0378331005", "us0378331005", "US03783310O5"},
+			"validation_is_isin",
+		},
+		{
+			"CUSIP", is.CUSIP,
+			[]string{"037833100", "38259P508", "594918104"},
+			[]string{"037833101", "03783310", "38259p508", "38259P50X"},
+			"validation_is_cusip",
+		},
+		{
+			"SEDOL", is.SEDOL,
+			[]string{"0263494", "B0YBKJ7", "B0YBLH2", "B0WNLY7"},
+			[]string{"0263495", "B0YBKJ", "A0YBKJ7", "B0YBKE7"},
+			"validation_is_sedol",
+		},
+		{
+			"LEI", is.LEI,
+			[]string{"5493001KJTIIGC8Y1R12", "7LTWFZYICNSX8D621K86"},
+			[]string{"5493001KJTIIGC8Y1R13", "5493001KJTIIGC8Y1R1", "5493001kjtiigc8y1r12", "5493001KJTIIGC8Y1RAB"},
+			"validation_is_lei",
+		},
+	}
+	for _, test := range tests {
+		assert.Nil(t, test.rule.Validate(""), test.tag)
+		for _, value := range test.valid {
+			assert.Nil(t, test.rule.Validate(value), test.tag+" "+value)
+		}
+		for _, value := range test.invalid {
+			err := test.rule.Validate(value)
+			if assert.NotNil(t, err, test.tag+" "+value) {
+				assert.Equal(t, test.code, err.(validation.Error).Code(), test.tag+" "+value)
+			}
+		}
+	}
+}
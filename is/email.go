@@ -0,0 +1,349 @@
+package is
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is/utils"
+)
+
+// ErrEmailDomain is the error that returns in case of an email address whose domain does not accept email.
+var ErrEmailDomain = validation.NewError("validation_is_email_domain", "must have a domain that accepts email")
+
+const (
+	// DefaultEmailCacheTTL is the time a domain lookup result is cached by EmailWith by default.
+	DefaultEmailCacheTTL = 10 * time.Minute
+	// DefaultEmailCacheSize is the maximum number of domains cached by EmailWith by default.
+	DefaultEmailCacheSize = 1000
+)
+
+type (
+	// Resolver looks up the DNS records used to check if the domain of an email address accepts email.
+	// *net.Resolver implements it, and MapResolver is an in-memory implementation for tests.
+	Resolver interface {
+		LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+		LookupHost(ctx context.Context, host string) ([]string, error)
+	}
+
+	// MapResolver is an in-memory Resolver. MX maps domains to the hosts of their mail exchangers,
+	// while Hosts maps host names to their addresses. Names that are not found fail with a not found *net.DNSError.
+	MapResolver struct {
+		MX    map[string][]string
+		Hosts map[string][]string
+	}
+
+	// EmailOptions configures the email address checks performed by EmailWith.
+	EmailOptions struct {
+		// Resolver is used to check if the domain accepts email, i.e. it has an MX record or, failing that,
+		// an address record. The domain is not checked if it is nil.
+		Resolver Resolver
+		// Timeout limits the time spent looking up a domain, in addition to the deadline of the context.
+		// There is no limit if it is zero.
+		Timeout time.Duration
+		// CacheTTL is the time a lookup result is cached for. DefaultEmailCacheTTL is used if it is zero,
+		// and results are not cached if it is negative. Lookups that fail are not cached.
+		CacheTTL time.Duration
+		// CacheSize is the maximum number of domains cached. DefaultEmailCacheSize is used if it is zero.
+		CacheSize int
+		// AllowDisplayName allows addresses with a display name, such as "John Doe <john@example.com>".
+		AllowDisplayName bool
+		// AllowQuotedLocalPart allows local parts that are quoted strings, such as "\"john doe\"@example.com".
+		AllowQuotedLocalPart bool
+		// AllowUTF8 allows internationalized addresses (RFC 6531), such as "jöhn@bücher.de", whose local part
+		// may contain non-ASCII letters and symbols and whose domain may be an internationalized domain name.
+		// The domain is converted to its ASCII form with utils.ToASCII before it is checked and looked up.
+		AllowUTF8 bool
+	}
+
+	// EmailRule is a validation rule that checks an email address with the given options.
+	EmailRule struct {
+		opts      EmailOptions
+		cache     *domainCache
+		err       validation.Error
+		domainErr validation.Error
+	}
+
+	// domainCache caches whether domains accept email.
+	domainCache struct {
+		mu      sync.Mutex
+		entries map[string]domainEntry
+	}
+
+	domainEntry struct {
+		ok      bool
+		expires time.Time
+	}
+)
+
+// EmailWith returns a context-aware validation rule that checks if a string is an email address.
+// If a resolver is given, it also checks if the domain accepts email, honoring the deadline of the context
+// and the timeout in the options. A domain that does not accept email fails with ErrEmailDomain,
+// while a lookup that fails for other reasons, e.g. a timeout, results in an internal error.
+// The lookup results are cached per domain by the rule and its copies. For example,
+//
+//	rule := is.EmailWith(is.EmailOptions{Resolver: net.DefaultResolver, Timeout: 2 * time.Second})
+//	err := validation.ValidateWithContext(ctx, "john@example.com", rule)
+func EmailWith(opts EmailOptions) EmailRule {
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = DefaultEmailCacheTTL
+	}
+	if opts.CacheSize <= 0 {
+		opts.CacheSize = DefaultEmailCacheSize
+	}
+	return EmailRule{
+		opts:      opts,
+		cache:     &domainCache{entries: map[string]domainEntry{}},
+		err:       ErrEmail,
+		domainErr: ErrEmailDomain,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r EmailRule) Error(message string) EmailRule {
+	r.err = r.err.SetMessage(message)
+	r.domainErr = r.domainErr.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EmailRule) ErrorObject(err validation.Error) EmailRule {
+	r.err = err
+	r.domainErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r EmailRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+func (r EmailRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+	address, ok := r.parse(str)
+	if !ok {
+		return r.err
+	}
+	if r.opts.Resolver == nil {
+		return nil
+	}
+
+	domain := strings.TrimSuffix(strings.ToLower(address[strings.LastIndex(address, "@")+1:]), ".")
+	if ok, err = r.acceptsEmail(ctx, domain); err != nil {
+		return validation.NewInternalError(err)
+	}
+	if !ok {
+		return r.domainErr
+	}
+	return nil
+}
+
+// parse returns the email address in str if it is valid.
+func (r EmailRule) parse(str string) (string, bool) {
+	if r.opts.AllowDisplayName && strings.HasSuffix(str, ">") {
+		a, err := mail.ParseAddress(str)
+		if err != nil {
+			return "", false
+		}
+		str = a.Address
+		if at := strings.LastIndex(str, "@"); at > 0 && !utils.IsEmail(str) {
+			// restore the quotes removed by ParseAddress
+			str = quoteLocalPart(str[:at]) + str[at:]
+		}
+	}
+
+	at := strings.LastIndex(str, "@")
+	if at <= 0 || at > 64 {
+		return "", false
+	}
+	local, domain := str[:at], str[at+1:]
+	if !utils.IsASCII(str) {
+		// the email pattern accepts some non-ASCII characters, which are only allowed in internationalized addresses
+		var ok bool
+		if !r.opts.AllowUTF8 {
+			return "", false
+		}
+		if domain, ok = utils.ToASCII(domain); !ok {
+			return "", false
+		}
+		if local, ok = asciiLocalPart(local); !ok {
+			return "", false
+		}
+		str = str[:at+1] + domain
+	}
+	if len(str) > 254 {
+		return "", false
+	}
+
+	if strings.HasPrefix(local, `"`) {
+		if !r.opts.AllowQuotedLocalPart || !isQuotedString(local) {
+			return "", false
+		}
+		// check the domain with a placeholder local part
+		return str, utils.IsEmail("x@" + domain)
+	}
+	return str, utils.IsEmail(local + "@" + domain)
+}
+
+// acceptsEmail checks if the domain has an MX record or, failing that, an address record.
+func (r EmailRule) acceptsEmail(ctx context.Context, domain string) (bool, error) {
+	if ok, found := r.cache.get(domain); found {
+		return ok, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if r.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.opts.Timeout)
+		defer cancel()
+	}
+
+	ok := false
+	mx, err := r.opts.Resolver.LookupMX(ctx, domain)
+	if err == nil && len(mx) > 0 {
+		// a single MX record with the host "." means that the domain does not accept email (RFC 7505)
+		ok = len(mx) > 1 || mx[0].Host != "." && mx[0].Host != ""
+	} else {
+		if err != nil && !isNotFound(err) {
+			return false, err
+		}
+		hosts, err := r.opts.Resolver.LookupHost(ctx, domain)
+		if err != nil && !isNotFound(err) {
+			return false, err
+		}
+		ok = len(hosts) > 0
+	}
+
+	if r.opts.CacheTTL > 0 {
+		r.cache.set(domain, ok, time.Now().Add(r.opts.CacheTTL), r.opts.CacheSize)
+	}
+	return ok, nil
+}
+
+func (c *domainCache) get(domain string) (bool, bool) {
+	if c == nil {
+		return false, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, found := c.entries[domain]
+	if !found || time.Now().After(e.expires) {
+		return false, false
+	}
+	return e.ok, true
+}
+
+func (c *domainCache) set(domain string, ok bool, expires time.Time, size int) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= size {
+		now := time.Now()
+		for d, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, d)
+			}
+		}
+		for d := range c.entries {
+			if len(c.entries) < size {
+				break
+			}
+			delete(c.entries, d)
+		}
+	}
+	c.entries[domain] = domainEntry{ok: ok, expires: expires}
+}
+
+// LookupMX returns the mail exchangers of the given domain.
+func (r MapResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	hosts, ok := r.MX[strings.TrimSuffix(name, ".")]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	mx := make([]*net.MX, len(hosts))
+	for i, host := range hosts {
+		mx[i] = &net.MX{Host: host, Pref: uint16(10 * (i + 1))}
+	}
+	return mx, nil
+}
+
+// LookupHost returns the addresses of the given host.
+func (r MapResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	addrs, ok := r.Hosts[strings.TrimSuffix(host, ".")]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// isQuotedString checks if s is a quoted string as defined by RFC 5322, without folding white space.
+func isQuotedString(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			// quoted pair
+			if i++; i == len(s)-1 || s[i] < 0x20 && s[i] != '\t' || s[i] > 0x7e {
+				return false
+			}
+		case c == '"', c < 0x20 && c != '\t', c > 0x7e:
+			return false
+		}
+	}
+	return true
+}
+
+// asciiLocalPart replaces the non-ASCII characters of an internationalized local part with a placeholder letter,
+// so that it can be checked like an ASCII local part. It returns false if there are non-printable characters.
+func asciiLocalPart(local string) (string, bool) {
+	var sb strings.Builder
+	for _, c := range local {
+		switch {
+		case c < utf8.RuneSelf:
+			sb.WriteRune(c)
+		case c != utf8.RuneError && unicode.IsGraphic(c) && !unicode.IsSpace(c):
+			sb.WriteByte('x')
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
+
+// quoteLocalPart quotes a local part that was unquoted by mail.ParseAddress.
+func quoteLocalPart(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,154 @@
+package is_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingResolver struct {
+	is.Resolver
+	lookups int
+}
+
+func (r *countingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.lookups++
+	return r.Resolver.LookupMX(ctx, name)
+}
+
+type failingResolver struct{}
+
+func (failingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	return nil, &net.DNSError{Err: "server misbehaving", Name: name, IsTemporary: true}
+}
+
+func (failingResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return nil, &net.DNSError{Err: "server misbehaving", Name: host, IsTemporary: true}
+}
+
+func TestEmailWith(t *testing.T) {
+	resolver := is.MapResolver{
+		MX: map[string][]string{
+			"example.com":      {"mx1.example.com", "mx2.example.com"},
+			"xn--bcher-kva.de": {"mx.xn--bcher-kva.de"},
+			"null.com":         {"."},
+		},
+		Hosts: map[string][]string{
+			"host.com": {"93.184.216.34"},
+		},
+	}
+	offline := is.EmailWith(is.EmailOptions{})
+	online := is.EmailWith(is.EmailOptions{Resolver: resolver})
+	relaxed := is.EmailWith(is.EmailOptions{AllowDisplayName: true, AllowQuotedLocalPart: true})
+	eai := is.EmailWith(is.EmailOptions{Resolver: resolver, AllowUTF8: true, AllowDisplayName: true})
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  string
+	}{
+		{"t1.1", offline, "john@example.com", ""},
+		{"t1.2", offline, "John.Doe@Example.COM", ""},
+		{"t1.3", offline, "", ""},
+		{"t1.4", offline, "john", "validation_is_email"},
+		{"t1.5", offline, "John <john@example.com>", "validation_is_email"},
+		{"t1.6", offline, `"john doe"@example.com`, "validation_is_email"},
+		{"t1.7", offline, "john@nowhere.invalid", ""},
+		{"t2.1", online, "john@example.com", ""},
+		{"t2.2", online, "john@EXAMPLE.com.", ""},
+		{"t2.3", online, "john@host.com", ""},
+		{"t2.4", online, "john@null.com", "validation_is_email_domain"},
+		{"t2.5", online, "john@nowhere.com", "validation_is_email_domain"},
+		{"t2.6", online, "john", "validation_is_email"},
+		{"t3.1", relaxed, "John Doe <john@example.com>", ""},
+		{"t3.2", relaxed, `"Doe, John" <john@example.com>`, ""},
+		{"t3.3", relaxed, `"john doe"@example.com`, ""},
+		{"t3.4", relaxed, `"john \"jd\" doe"@example.com`, ""},
+		{"t3.5", relaxed, `John <"john doe"@example.com>`, ""},
+		{"t3.6", relaxed, `"john"doe"@example.com`, "validation_is_email"},
+		{"t3.7", relaxed, `"john doe"@example`, "validation_is_email"},
+		{"t3.8", relaxed, "John Doe <john>", "validation_is_email"},
+		{"t3.9", relaxed, "jöhn@example.com", "validation_is_email"},
+		{"t4.1", eai, "jöhn@bücher.de", ""},
+		{"t4.2", eai, "用户@例子.广告", "validation_is_email_domain"},
+		{"t4.3", eai, "Jöhn <jöhn@bücher.de>", ""},
+		{"t4.4", eai, "john@xn--bcher-kva.de", ""},
+		{"t4.5", eai, "jöhn@bü cher.de", "validation_is_email"},
+		{"t4.6", eai, "jö\u2028hn@bücher.de", "validation_is_email"},
+		{"t4.7", eai, "☃@bücher.de", ""},
+		{"t4.8", eai, "john@☃.de", "validation_is_email"},
+		{"t4.9", eai, strings.Repeat("ö", 33) + "@bücher.de", "validation_is_email"},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		if test.code == "" {
+			assert.Nil(t, err, test.tag)
+		} else if assert.NotNil(t, err, test.tag) {
+			assert.Equal(t, test.code, err.(validation.Error).Code(), test.tag)
+		}
+	}
+
+	err := online.Validate(123)
+	assert.EqualError(t, err, "must be either a string or byte slice")
+
+	err = online.Error("invalid address").Validate("john@null.com")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid address", err.Error())
+		assert.Equal(t, "validation_is_email_domain", err.(validation.Error).Code())
+	}
+	err = online.ErrorObject(validation.NewError("code", "abc")).Validate("john")
+	assert.Equal(t, validation.NewError("code", "abc"), err)
+}
+
+func TestEmailWithCache(t *testing.T) {
+	resolver := &countingResolver{Resolver: is.MapResolver{MX: map[string][]string{"example.com": {"mx.example.com"}}}}
+	rule := is.EmailWith(is.EmailOptions{Resolver: resolver})
+	assert.Nil(t, rule.Validate("john@example.com"))
+	assert.Nil(t, rule.Error("abc").Validate("jane@Example.com"))
+	assert.Equal(t, 1, resolver.lookups)
+
+	resolver.lookups = 0
+	rule = is.EmailWith(is.EmailOptions{Resolver: resolver, CacheTTL: -1})
+	assert.Nil(t, rule.Validate("john@example.com"))
+	assert.Nil(t, rule.Validate("john@example.com"))
+	assert.Equal(t, 2, resolver.lookups)
+
+	resolver.lookups = 0
+	rule = is.EmailWith(is.EmailOptions{Resolver: resolver, CacheSize: 1})
+	assert.Nil(t, rule.Validate("john@example.com"))
+	assert.NotNil(t, rule.Validate("john@example.org"))
+	assert.Nil(t, rule.Validate("john@example.com"))
+	assert.Equal(t, 3, resolver.lookups)
+}
+
+func TestEmailWithLookupFailure(t *testing.T) {
+	rule := is.EmailWith(is.EmailOptions{Resolver: failingResolver{}})
+	err := rule.Validate("john@example.com")
+	if assert.NotNil(t, err) {
+		_, ok := err.(validation.InternalError)
+		assert.True(t, ok)
+	}
+
+	// failed lookups are not cached
+	resolver := &countingResolver{Resolver: is.MapResolver{MX: map[string][]string{"example.com": {"mx.example.com"}}}}
+	rule = is.EmailWith(is.EmailOptions{Resolver: resolver, Timeout: time.Second})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = validation.ValidateWithContext(ctx, "john@example.com", rule)
+	if assert.NotNil(t, err) {
+		ie, ok := err.(validation.InternalError)
+		if assert.True(t, ok) {
+			assert.True(t, errors.Is(ie.InternalError(), context.Canceled))
+		}
+	}
+	assert.Nil(t, validation.ValidateWithContext(context.Background(), "john@example.com", rule))
+	assert.Equal(t, 2, resolver.lookups)
+}
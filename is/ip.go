@@ -0,0 +1,241 @@
+package is
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is/utils"
+)
+
+var (
+	// ErrCIDR is the error that returns in case of an invalid CIDR notation.
+	ErrCIDR = validation.NewError("validation_is_cidr", "must be a valid CIDR notation")
+	// ErrIPInRanges is the error that returns in case of an IP address or network outside the allowed ranges.
+	ErrIPInRanges = validation.NewError("validation_is_ip_in_ranges", "must be within the allowed IP ranges")
+	// ErrPublicIP is the error that returns in case of an IP address or network that is not public.
+	ErrPublicIP = validation.NewError("validation_is_public_ip", "must be a public IP address")
+	// ErrPrivateIP is the error that returns in case of an IP address or network that is not private.
+	ErrPrivateIP = validation.NewError("validation_is_private_ip", "must be a private IP address")
+	// ErrLoopbackIP is the error that returns in case of an IP address or network that is not a loopback one.
+	ErrLoopbackIP = validation.NewError("validation_is_loopback_ip", "must be a loopback IP address")
+	// ErrReservedIP is the error that returns in case of an IP address or network in a reserved range.
+	ErrReservedIP = validation.NewError("validation_is_reserved_ip", "must not be a reserved IP address")
+)
+
+var (
+	// CIDR validates if a string, netip.Prefix or net.IPNet is a valid CIDR notation (either version 4 or 6)
+	CIDR = validation.NewStringRuleWithError(utils.IsCIDR, ErrCIDR).WithNative(func(value interface{}) (bool, bool) {
+		switch v := value.(type) {
+		case netip.Prefix:
+			return v.IsValid(), true
+		case net.IPNet:
+			_, bits := v.Mask.Size()
+			return bits != 0 && (len(v.IP) == net.IPv4len || len(v.IP) == net.IPv6len), true
+		}
+		return false, false
+	})
+
+	// PublicIP validates if an IP address or network is entirely public, i.e. globally routable.
+	// It fails for private, loopback, link-local, multicast, shared, documentation and other special-purpose ranges.
+	// The IPv6 addresses embedding an IPv4 address (NAT64, 6to4 and IPv4-compatible addresses) must embed
+	// a public one, e.g. "64:ff9b::a00:1", which translates to "10.0.0.1", is not public.
+	PublicIP = IPRule{check: isPublicIP, err: ErrPublicIP}
+	// PrivateIP validates if an IP address or network is entirely within a private range (RFC 1918 or RFC 4193).
+	PrivateIP = IPRule{check: func(p netip.Prefix) bool { return withinAny(p, privateRanges) }, err: ErrPrivateIP}
+	// LoopbackIP validates if an IP address or network is entirely within a loopback range.
+	LoopbackIP = IPRule{check: func(p netip.Prefix) bool { return withinAny(p, loopbackRanges) }, err: ErrLoopbackIP}
+	// NotReservedIP validates if an IP address or network does not overlap a range that is reserved and therefore
+	// never used by hosts, such as the unspecified address, documentation, benchmarking and future use ranges.
+	// Private, loopback, link-local and multicast addresses are allowed.
+	NotReservedIP = IPRule{check: func(p netip.Prefix) bool { return !overlapsAny(p, reservedRanges) }, err: ErrReservedIP}
+)
+
+var (
+	loopbackRanges = prefixes("127.0.0.0/8", "::1/128")
+	privateRanges  = prefixes("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+	reservedRanges = prefixes(
+		"0.0.0.0/8",       // "this" network
+		"192.0.0.0/24",    // IETF protocol assignments
+		"192.0.2.0/24",    // documentation (TEST-NET-1)
+		"198.18.0.0/15",   // benchmarking
+		"198.51.100.0/24", // documentation (TEST-NET-2)
+		"203.0.113.0/24",  // documentation (TEST-NET-3)
+		"240.0.0.0/4",     // reserved for future use, including the limited broadcast address
+		"::/128",          // unspecified address
+		"100::/64",        // discard-only
+		"2001:2::/48",     // benchmarking
+		"2001:db8::/32",   // documentation
+		"3fff::/20",       // documentation
+	)
+	nonPublicRanges = append(prefixes(
+		"10.0.0.0/8",     // private
+		"100.64.0.0/10",  // shared address space
+		"127.0.0.0/8",    // loopback
+		"169.254.0.0/16", // link-local
+		"172.16.0.0/12",  // private
+		"192.88.99.0/24", // 6to4 relay anycast
+		"192.168.0.0/16", // private
+		"224.0.0.0/4",    // multicast
+		"::1/128",        // loopback
+		"64:ff9b:1::/48", // local-use IPv4/IPv6 translation
+		"2001::/23",      // IETF protocol assignments
+		"fc00::/7",       // unique local
+		"fe80::/10",      // link-local
+		"ff00::/8",       // multicast
+	), reservedRanges...)
+
+	// ipv4Embeddings are the IPv6 ranges whose addresses embed IPv4 addresses starting at the given bit.
+	ipv4Embeddings = []struct {
+		prefix netip.Prefix
+		offset int
+	}{
+		{netip.MustParsePrefix("64:ff9b::/96"), 96}, // NAT64 well-known prefix
+		{netip.MustParsePrefix("2002::/16"), 16},    // 6to4
+		{netip.MustParsePrefix("::/96"), 96},        // IPv4-compatible, deprecated
+	}
+)
+
+// IPRule is a validation rule that checks an IP address or network given as a string, net.IP, netip.Addr
+// or netip.Prefix. A network is valid only if all of its addresses are.
+type IPRule struct {
+	check func(netip.Prefix) bool
+	err   validation.Error
+}
+
+// IPInRanges returns a validation rule that checks if an IP address or network is entirely within one of the given
+// ranges. For example,
+//
+//	is.IPInRanges(netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8"))
+//
+// The ranges are reported in the "ranges" parameter of the error.
+func IPInRanges(ranges ...netip.Prefix) IPRule {
+	names := make([]string, len(ranges))
+	normalized := make([]netip.Prefix, len(ranges))
+	for i, r := range ranges {
+		names[i] = r.String()
+		normalized[i] = unmapPrefix(r.Masked())
+	}
+	return IPRule{
+		check: func(p netip.Prefix) bool { return withinAny(p, normalized) },
+		err:   ErrIPInRanges.SetParams(map[string]interface{}{"ranges": names}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r IPRule) Error(message string) IPRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r IPRule) ErrorObject(err validation.Error) IPRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r IPRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	p, err := toPrefix(value)
+	if err != nil {
+		return err
+	}
+	if !p.IsValid() || !r.check(p) {
+		return r.err
+	}
+	return nil
+}
+
+// toPrefix converts an IP address or network to a prefix. An IP address is converted to a single-address prefix.
+// The returned prefix is invalid if the value cannot be parsed.
+func toPrefix(value interface{}) (netip.Prefix, error) {
+	var addr netip.Addr
+	switch v := value.(type) {
+	case netip.Prefix:
+		return unmapPrefix(v.Masked()), nil
+	case netip.Addr:
+		addr = v
+	case net.IP:
+		addr, _ = netip.AddrFromSlice(v)
+	default:
+		str, err := validation.EnsureString(value)
+		if err != nil {
+			return netip.Prefix{}, errors.New("must be either a string, a byte slice, net.IP, netip.Addr or netip.Prefix")
+		}
+		if addr, err = netip.ParseAddr(str); err != nil {
+			p, _ := netip.ParsePrefix(str)
+			return unmapPrefix(p.Masked()), nil
+		}
+	}
+	if !addr.IsValid() {
+		return netip.Prefix{}, nil
+	}
+	addr = addr.WithZone("").Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// unmapPrefix converts a prefix of IPv4-mapped IPv6 addresses to the equivalent IPv4 prefix.
+func unmapPrefix(p netip.Prefix) netip.Prefix {
+	if p.IsValid() && p.Addr().Is4In6() && p.Bits() >= 96 {
+		return netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	return p
+}
+
+// isPublicIP checks if the prefix p is entirely public, including the IPv4 addresses embedded in it.
+func isPublicIP(p netip.Prefix) bool {
+	if overlapsAny(p, nonPublicRanges) {
+		return false
+	}
+	for _, e := range ipv4Embeddings {
+		if !e.prefix.Overlaps(p) {
+			continue
+		}
+		if p.Bits() < e.prefix.Bits() {
+			// p embeds all IPv4 addresses, including the non-public ones
+			return false
+		}
+		b := p.Addr().As16()
+		bits := p.Bits()
+		if bits > e.offset+32 {
+			bits = e.offset + 32
+		}
+		addr := netip.AddrFrom4([4]byte(b[e.offset/8 : e.offset/8+4]))
+		return isPublicIP(netip.PrefixFrom(addr, bits-e.offset).Masked())
+	}
+	return true
+}
+
+// withinAny checks if the prefix p is entirely within one of the ranges.
+func withinAny(p netip.Prefix, ranges []netip.Prefix) bool {
+	for _, r := range ranges {
+		if r.Bits() <= p.Bits() && r.Contains(p.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// overlapsAny checks if the prefix p overlaps one of the ranges.
+func overlapsAny(p netip.Prefix, ranges []netip.Prefix) bool {
+	for _, r := range ranges {
+		if r.Overlaps(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func prefixes(s ...string) []netip.Prefix {
+	ps := make([]netip.Prefix, len(s))
+	for i, p := range s {
+		ps[i] = netip.MustParsePrefix(p)
+	}
+	return ps
+}
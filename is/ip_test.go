@@ -0,0 +1,113 @@
+package is_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDR(t *testing.T) {
+	_, ipNet, _ := net.ParseCIDR("10.0.0.0/8")
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "10.0.0.0/8", ""},
+		{"t2", "2001:db8::/32", ""},
+		{"t3", "10.0.0.0", "must be a valid CIDR notation"},
+		{"t4", netip.MustParsePrefix("10.0.0.0/8"), ""},
+		{"t5", ipNet, ""},
+		{"t6", *ipNet, ""},
+		{"t7", net.IPNet{IP: net.IP{1, 2}}, "must be a valid CIDR notation"},
+		{"t8", "", ""},
+		{"t9", netip.Prefix{}, ""},
+	}
+	for _, test := range tests {
+		assertError(t, test.err, is.CIDR.Validate(test.value), test.tag)
+	}
+}
+
+func TestIPRules(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", is.PublicIP, "8.8.8.8", ""},
+		{"t1.2", is.PublicIP, "10.1.2.3", "must be a public IP address"},
+		{"t1.3", is.PublicIP, "127.0.0.1", "must be a public IP address"},
+		{"t1.4", is.PublicIP, "::ffff:192.168.1.1", "must be a public IP address"},
+		{"t1.5", is.PublicIP, "2606:4700:4700::1111", ""},
+		{"t1.6", is.PublicIP, "fe80::1%eth0", "must be a public IP address"},
+		{"t1.7", is.PublicIP, "192.0.2.1", "must be a public IP address"},
+		{"t1.8", is.PublicIP, "8.0.0.0/7", ""},
+		{"t1.9", is.PublicIP, "8.0.0.0/4", "must be a public IP address"},
+		{"t1.10", is.PublicIP, net.ParseIP("100.64.0.1"), "must be a public IP address"},
+		{"t1.11", is.PublicIP, netip.MustParseAddr("1.1.1.1"), ""},
+		{"t1.12", is.PublicIP, "not an ip", "must be a public IP address"},
+		{"t1.13", is.PublicIP, "", ""},
+		{"t1.14", is.PublicIP, net.IP(nil), ""},
+		{"t1.15", is.PublicIP, 123, "must be either a string, a byte slice, net.IP, netip.Addr or netip.Prefix"},
+		{"t1.16", is.PublicIP, "64:ff9b::a00:1", "must be a public IP address"},
+		{"t1.17", is.PublicIP, "2002:7f00:1::1", "must be a public IP address"},
+		{"t1.18", is.PublicIP, "::127.0.0.1", "must be a public IP address"},
+		{"t1.19", is.PublicIP, "64:ff9b::a9fe:a9fe", "must be a public IP address"},
+		{"t1.20", is.PublicIP, "2002:a9fe:a9fe::", "must be a public IP address"},
+		{"t1.21", is.PublicIP, "64:ff9b::808:808", ""},
+		{"t1.22", is.PublicIP, "2002:808:808::1", ""},
+		{"t1.23", is.PublicIP, "::8.8.8.8", ""},
+		{"t1.24", is.PublicIP, "2002:808::/32", ""},
+		{"t1.25", is.PublicIP, "2002::/16", "must be a public IP address"},
+		{"t1.26", is.PublicIP, "2002:a00::/24", "must be a public IP address"},
+		{"t1.27", is.PublicIP, "64:ff9b::/64", "must be a public IP address"},
+		{"t2.1", is.PrivateIP, "10.1.2.3", ""},
+		{"t2.2", is.PrivateIP, "172.31.255.255", ""},
+		{"t2.3", is.PrivateIP, "172.32.0.0", "must be a private IP address"},
+		{"t2.4", is.PrivateIP, "fd12:3456::1", ""},
+		{"t2.5", is.PrivateIP, netip.MustParsePrefix("192.168.0.0/16"), ""},
+		{"t2.6", is.PrivateIP, netip.MustParsePrefix("192.168.0.0/15"), "must be a private IP address"},
+		{"t2.7", is.PrivateIP, []byte("192.168.1.1"), ""},
+		{"t3.1", is.LoopbackIP, "127.0.0.1", ""},
+		{"t3.2", is.LoopbackIP, "::1", ""},
+		{"t3.3", is.LoopbackIP, net.IPv4(127, 1, 2, 3), ""},
+		{"t3.4", is.LoopbackIP, "10.0.0.1", "must be a loopback IP address"},
+		{"t4.1", is.NotReservedIP, "10.0.0.1", ""},
+		{"t4.2", is.NotReservedIP, "127.0.0.1", ""},
+		{"t4.3", is.NotReservedIP, "0.0.0.0", "must not be a reserved IP address"},
+		{"t4.4", is.NotReservedIP, "255.255.255.255", "must not be a reserved IP address"},
+		{"t4.5", is.NotReservedIP, "2001:db8::1", "must not be a reserved IP address"},
+		{"t4.6", is.NotReservedIP, "198.0.0.0/8", "must not be a reserved IP address"},
+		{"t4.7", is.NotReservedIP, "::", "must not be a reserved IP address"},
+	}
+	for _, test := range tests {
+		assertError(t, test.err, test.rule.Validate(test.value), test.tag)
+	}
+}
+
+func TestIPInRanges(t *testing.T) {
+	rule := is.IPInRanges(netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8"))
+	assert.Nil(t, rule.Validate("10.1.2.3"))
+	assert.Nil(t, rule.Validate("::ffff:10.1.2.3"))
+	assert.Nil(t, rule.Validate("10.1.0.0/16"))
+	assert.Nil(t, rule.Validate(netip.MustParseAddr("fd00::1")))
+	assert.Nil(t, rule.Validate(net.ParseIP("10.0.0.1")))
+
+	err := rule.Validate("11.0.0.1")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "must be within the allowed IP ranges", err.Error())
+		e := err.(validation.Error)
+		assert.Equal(t, "validation_is_ip_in_ranges", e.Code())
+		assert.Equal(t, []string{"10.0.0.0/8", "fd00::/8"}, e.Params()["ranges"])
+	}
+	assert.NotNil(t, rule.Validate("10.0.0.0/7"))
+
+	assert.EqualError(t, rule.Error("not allowed").Validate("11.0.0.1"), "not allowed")
+	err = rule.ErrorObject(validation.NewError("code", "abc")).Validate("11.0.0.1")
+	assert.EqualError(t, err, "abc")
+}
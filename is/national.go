@@ -0,0 +1,333 @@
+package is
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/prodadidb/go-validation"
+)
+
+var (
+	// ErrNationalIDCountry is the error that returns in case of a national identity number whose country
+	// is not registered. Its "country" parameter holds the country.
+	ErrNationalIDCountry = validation.NewError("validation_is_national_id_country", "cannot be validated for the given country")
+	// ErrDNI is the error that returns in case of an invalid Spanish DNI or NIE.
+	ErrDNI = validation.NewError("validation_is_dni", "must be a valid DNI or NIE")
+	// ErrCodiceFiscale is the error that returns in case of an invalid Italian codice fiscale.
+	ErrCodiceFiscale = validation.NewError("validation_is_codice_fiscale", "must be a valid codice fiscale")
+	// ErrPersonnummer is the error that returns in case of an invalid Swedish personnummer.
+	ErrPersonnummer = validation.NewError("validation_is_personnummer", "must be a valid personnummer")
+	// ErrBSN is the error that returns in case of an invalid Dutch BSN.
+	ErrBSN = validation.NewError("validation_is_bsn", "must be a valid BSN")
+	// ErrChineseResidentID is the error that returns in case of an invalid Chinese resident identity card number.
+	ErrChineseResidentID = validation.NewError("validation_is_chinese_resident_id", "must be a valid resident identity card number")
+	// ErrAadhaar is the error that returns in case of an invalid Indian Aadhaar number.
+	ErrAadhaar = validation.NewError("validation_is_aadhaar", "must be a valid Aadhaar number")
+	// ErrMRZ is the error that returns in case of an invalid machine-readable zone.
+	ErrMRZ = validation.NewError("validation_is_mrz", "must be a valid machine-readable zone")
+)
+
+// MRZ validates if a string is a valid machine-readable zone of a travel document (ICAO 9303), including
+// its check digits. The zone of a passport (TD3, 2 lines of 44 characters) or an identity card (TD1, 3 lines
+// of 30 characters, or TD2, 2 lines of 36 characters) may be given with or without line breaks.
+var MRZ = validation.NewStringRuleWithError(isMRZ, ErrMRZ)
+
+type (
+	// NationalIDRule is a validation rule that checks a national identity number according to the format of a country.
+	NationalIDRule struct {
+		country    string
+		countryPtr interface{}
+		err        validation.Error
+	}
+
+	// nationalID is the check of the national identity numbers of a country and its error.
+	nationalID struct {
+		check func(string) bool
+		err   validation.Error
+	}
+)
+
+// nationalIDs maps countries to the checks of their national identity numbers.
+var nationalIDs = map[string]nationalID{
+	"ES": {func(s string) bool { return isDNI(s) || isNIE(s) }, ErrDNI},
+	"IT": {isCodiceFiscale, ErrCodiceFiscale},
+	"SE": {isPersonnummer, ErrPersonnummer},
+	"NL": {isBSN, ErrBSN},
+	"CN": {isChineseResidentID, ErrChineseResidentID},
+	"IN": {isAadhaar, ErrAadhaar},
+}
+
+// RegisterNationalID registers the check of the national identity numbers of a country, identified by its
+// ISO 3166 alpha-2 code, and the error returned for invalid numbers. It replaces any check registered before,
+// including the built-in ones. The check is given the number without white spaces and hyphens and with
+// upper case letters. For example,
+//
+//	is.RegisterNationalID("PL", isPESEL, validation.NewError("validation_pesel", "must be a valid PESEL"))
+//
+// RegisterNationalID is not safe for concurrent use and should be called during initialization.
+func RegisterNationalID(country string, check func(string) bool, err validation.Error) {
+	nationalIDs[strings.ToUpper(country)] = nationalID{check: check, err: err}
+}
+
+// NationalID returns a validation rule that checks if a string is a valid national identity number of the given
+// country, identified by its ISO 3166 alpha-2 code, including its check digits. The following countries are
+// supported, each with its own error, and more can be added with RegisterNationalID:
+//   - "ES": a DNI or NIE
+//   - "IT": a codice fiscale
+//   - "SE": a personnummer or samordningsnummer, of either 10 or 12 digits
+//   - "NL": a BSN
+//   - "CN": a resident identity card number of 18 characters
+//   - "IN": an Aadhaar number
+//
+// White spaces and hyphens are ignored, as well as the case of letters. Like TaxID, the country may be taken
+// from another field with CountryFrom. If the country is not registered, ErrNationalIDCountry is returned.
+func NationalID(country string) NationalIDRule {
+	return NationalIDRule{country: country}
+}
+
+// CountryFrom configures the rule to take the country from the given pointer when validating,
+// such as a pointer to another field of the struct being validated. The country given to the rule is ignored.
+func (r NationalIDRule) CountryFrom(fieldPtr interface{}) NationalIDRule {
+	r.countryPtr = fieldPtr
+	return r
+}
+
+// Error sets the error message used for invalid numbers. The error codes of the countries are kept.
+func (r NationalIDRule) Error(message string) NationalIDRule {
+	if r.err == nil {
+		r.err = validation.NewError("", message)
+	} else {
+		r.err = r.err.SetMessage(message)
+	}
+	return r
+}
+
+// ErrorObject sets the error struct used for invalid numbers.
+func (r NationalIDRule) ErrorObject(err validation.Error) NationalIDRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NationalIDRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	country := r.country
+	if r.countryPtr != nil {
+		country = ""
+		if v, isNil := validation.Indirect(r.countryPtr); !isNil {
+			country, _ = validation.EnsureString(v)
+		}
+	}
+	country = strings.ToUpper(country)
+	id, ok := nationalIDs[country]
+	if !ok {
+		return ErrNationalIDCountry.SetParams(map[string]interface{}{"country": country})
+	}
+
+	if id.check(strings.ToUpper(reSeparators.ReplaceAllString(str, ""))) {
+		return nil
+	}
+	switch {
+	case r.err == nil:
+		return id.err
+	case r.err.Code() == "":
+		return id.err.SetMessage(r.err.Message())
+	}
+	return r.err
+}
+
+var (
+	reCodiceFiscale = regexp.MustCompile(`^[A-Z]{6}[0-9LMNPQRSTUV]{2}[ABCDEHLMPRST][0-9LMNPQRSTUV]{2}[A-Z][0-9LMNPQRSTUV]{3}[A-Z]$`)
+	rePersonnummer  = regexp.MustCompile(`^(?:[0-9]{2})?[0-9]{6}\+?[0-9]{4}$`)
+	reBSN           = regexp.MustCompile(`^[0-9]{8,9}$`)
+	reChineseID     = regexp.MustCompile(`^[1-9][0-9]{16}[0-9X]$`)
+	reAadhaar       = regexp.MustCompile(`^[2-9][0-9]{11}$`)
+	reMRZ           = regexp.MustCompile(`^[A-Z0-9<]+$`)
+
+	// codiceFiscaleOdd maps the characters of a codice fiscale to their values in the odd positions.
+	codiceFiscaleOdd = [...]int{
+		1, 0, 5, 7, 9, 13, 15, 17, 19, 21, // 0-9
+		1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23, // A-Z
+	}
+
+	// verhoeffD and verhoeffP are the multiplication and permutation tables of the Verhoeff algorithm.
+	verhoeffD = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, {1, 2, 3, 4, 0, 6, 7, 8, 9, 5}, {2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7}, {4, 0, 1, 2, 3, 9, 5, 6, 7, 8}, {5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2}, {7, 6, 5, 9, 8, 2, 1, 0, 4, 3}, {8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffP = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, {1, 5, 7, 6, 2, 8, 3, 0, 9, 4}, {5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7}, {9, 4, 5, 3, 1, 2, 6, 8, 7, 0}, {4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5}, {7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+)
+
+// isCodiceFiscale checks if a string is an Italian fiscal code of a person, including the codes whose
+// digits were replaced by letters to resolve collisions (omocodia).
+func isCodiceFiscale(s string) bool {
+	if !reCodiceFiscale.MatchString(s) {
+		return false
+	}
+	// restore the digits replaced by letters
+	b := []byte(s)
+	for _, i := range [...]int{6, 7, 9, 10, 12, 13, 14} {
+		if j := strings.IndexByte("LMNPQRSTUV", b[i]); j >= 0 {
+			b[i] = byte('0' + j)
+		}
+	}
+	day, _ := strconv.Atoi(string(b[9:11]))
+	if day > 40 {
+		// the day of birth of women is increased by 40
+		day -= 40
+	}
+	year, _ := strconv.Atoi(string(b[6:8]))
+	if !isDate(2000+year, strings.IndexByte("ABCDEHLMPRST", b[8])+1, day) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 15; i++ {
+		v := alnumValue(rune(s[i]))
+		switch {
+		case i%2 == 0:
+			sum += codiceFiscaleOdd[v]
+		case v >= 10:
+			// the letters of the even positions are worth 0 for "A" up to 25 for "Z"
+			sum += v - 10
+		default:
+			sum += v
+		}
+	}
+	return byte('A'+sum%26) == s[15]
+}
+
+// isPersonnummer checks if a string is a Swedish personal identity number or coordination number,
+// whose day of birth is increased by 60.
+func isPersonnummer(s string) bool {
+	if !rePersonnummer.MatchString(s) {
+		return false
+	}
+	s = strings.Replace(s, "+", "", 1)
+	year := 2000
+	if len(s) == 12 {
+		year, _ = strconv.Atoi(s[:4])
+		s = s[2:]
+	} else {
+		y, _ := strconv.Atoi(s[:2])
+		year += y
+	}
+	month, _ := strconv.Atoi(s[2:4])
+	day, _ := strconv.Atoi(s[4:6])
+	if day > 60 {
+		day -= 60
+	}
+	return isDate(year, month, day) && luhn(s)
+}
+
+// isBSN checks if a string is a Dutch citizen service number (burgerservicenummer).
+func isBSN(s string) bool {
+	if !reBSN.MatchString(s) {
+		return false
+	}
+	if len(s) == 8 {
+		s = "0" + s
+	}
+	sum := weightedSum(s[:8], 9, 8, 7, 6, 5, 4, 3, 2) - digit(s, 8)
+	return sum > 0 && sum%11 == 0
+}
+
+// isChineseResidentID checks if a string is a number of the resident identity card of the People's Republic of China.
+func isChineseResidentID(s string) bool {
+	if !reChineseID.MatchString(s) {
+		return false
+	}
+	year, _ := strconv.Atoi(s[6:10])
+	month, _ := strconv.Atoi(s[10:12])
+	day, _ := strconv.Atoi(s[12:14])
+	if !isDate(year, month, day) {
+		return false
+	}
+	sum := weightedSum(s[:17], 7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2)
+	return "10X98765432"[sum%11] == s[17]
+}
+
+// isAadhaar checks if a string is an Indian Aadhaar number, whose last digit is a Verhoeff check digit.
+func isAadhaar(s string) bool {
+	if !reAadhaar.MatchString(s) {
+		return false
+	}
+	c := 0
+	for i := 0; i < len(s); i++ {
+		c = verhoeffD[c][verhoeffP[i%8][digit(s, len(s)-1-i)]]
+	}
+	return c == 0
+}
+
+// isMRZ checks if a string is a machine-readable zone of the TD1, TD2 or TD3 format.
+func isMRZ(s string) bool {
+	s = strings.NewReplacer("\r", "", "\n", "", " ", "").Replace(s)
+	if !reMRZ.MatchString(s) {
+		return false
+	}
+	switch len(s) {
+	case 90:
+		// TD1: the document number is on the first line, and the other data on the second one
+		l1, l2 := s[:30], s[30:60]
+		return mrzDocumentNumber(l1[5:30]) &&
+			mrzCheck(l2[0:6], l2[6]) && mrzCheck(l2[8:14], l2[14]) &&
+			mrzCheck(l1[5:30]+l2[0:7]+l2[8:15]+l2[18:29], l2[29])
+	case 72, 88:
+		// TD2 and TD3: all data is on the second line
+		n := len(s) / 2
+		l2 := s[n:]
+		if !mrzCheck(l2[0:9], l2[9]) || !mrzCheck(l2[13:19], l2[19]) || !mrzCheck(l2[21:27], l2[27]) {
+			return false
+		}
+		if n == 44 && !(mrzCheck(l2[28:42], l2[42]) || l2[42] == '<' && strings.Trim(l2[28:42], "<") == "") {
+			return false
+		}
+		return mrzCheck(l2[0:10]+l2[13:20]+l2[21:n-1], l2[n-1])
+	}
+	return false
+}
+
+// mrzDocumentNumber checks the document number of a TD1 zone, given with the optional data that follows it.
+// A document number longer than 9 characters continues in the optional data, which then holds the check digit.
+func mrzDocumentNumber(s string) bool {
+	if s[9] != '<' {
+		return mrzCheck(s[:9], s[9])
+	}
+	end := strings.IndexByte(s[10:], '<')
+	if end < 0 {
+		end = len(s) - 10
+	}
+	if end == 0 {
+		// the document number has no check digit
+		return false
+	}
+	return mrzCheck(s[:9]+s[10:10+end-1], s[10+end-1])
+}
+
+// mrzCheck checks the check digit of a field of a machine-readable zone.
+func mrzCheck(field string, check byte) bool {
+	sum := 0
+	for i := 0; i < len(field); i++ {
+		v := 0
+		if field[i] != '<' {
+			v = alnumValue(rune(field[i]))
+		}
+		sum += v * [...]int{7, 3, 1}[i%3]
+	}
+	return byte('0'+sum%10) == check
+}
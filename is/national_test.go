@@ -0,0 +1,134 @@
+package is_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNationalID(t *testing.T) {
+	tests := []struct {
+		tag     string
+		country string
+		value   interface{}
+		err     string
+	}{
+		{"t1.1", "ES", "", ""},
+		{"t1.2", "ES", "12345678Z", ""},
+		{"t1.3", "es", "x-1234567-l", ""},
+		{"t1.4", "ES", "12345678A", "validation_is_dni"},
+		{"t2.1", "IT", "RSSMRA85T10A562S", ""},
+		{"t2.2", "IT", "MRTMTT91D08F205J", ""},
+		{"t2.3", "IT", "rssmra85t10a562s", ""},
+		{"t2.4", "IT", "RSSMRAURTMLARSNL", ""},
+		{"t2.5", "IT", "RSSMRA85T10A562T", "validation_is_codice_fiscale"},
+		{"t2.6", "IT", "RSSMRA85T32A562S", "validation_is_codice_fiscale"},
+		{"t3.1", "SE", "811218-9876", ""},
+		{"t3.2", "SE", "196403273813", ""},
+		{"t3.3", "SE", "640327+3813", ""},
+		{"t3.4", "SE", "811218-9877", "validation_is_personnummer"},
+		{"t3.5", "SE", "811318-9876", "validation_is_personnummer"},
+		{"t4.1", "NL", "111222333", ""},
+		{"t4.2", "NL", "111222334", "validation_is_bsn"},
+		{"t4.3", "NL", "000000000", "validation_is_bsn"},
+		{"t5.1", "CN", "11010519491231002X", ""},
+		{"t5.2", "CN", "440524188001010014", ""},
+		{"t5.3", "CN", "110105194912310021", "validation_is_chinese_resident_id"},
+		{"t5.4", "CN", "110105194913310029", "validation_is_chinese_resident_id"},
+		{"t6.1", "IN", "2341 2341 2346", ""},
+		{"t6.2", "IN", "499118665246", ""},
+		{"t6.3", "IN", "234123412347", "validation_is_aadhaar"},
+		{"t6.4", "IN", "123412341234", "validation_is_aadhaar"},
+		{"t7.1", "FR", "1234", "validation_is_national_id_country"},
+		{"t7.2", "", "1234", "validation_is_national_id_country"},
+		{"t8.1", "ES", []byte("12345678Z"), ""},
+		{"t8.2", "ES", 123, "must be either a string or byte slice"},
+	}
+	for _, test := range tests {
+		err := is.NationalID(test.country).Validate(test.value)
+		switch e := err.(type) {
+		case nil:
+			assert.Empty(t, test.err, test.tag)
+		case validation.Error:
+			assert.Equal(t, test.err, e.Code(), test.tag)
+		default:
+			assert.EqualError(t, err, test.err, test.tag)
+		}
+	}
+
+	err := is.NationalID("FR").Validate("1234")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "FR", err.(validation.Error).Params()["country"])
+	}
+
+	err = is.NationalID("NL").Error("invalid").Validate("111222334")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_bsn", err.(validation.Error).Code())
+		assert.Equal(t, "invalid", err.Error())
+	}
+	err = is.NationalID("NL").ErrorObject(validation.NewError("code", "abc")).Validate("111222334")
+	assert.Equal(t, validation.NewError("code", "abc"), err)
+}
+
+func TestNationalIDCountryFrom(t *testing.T) {
+	var country string
+	rule := is.NationalID("ES").CountryFrom(&country)
+
+	country = "nl"
+	assert.Nil(t, rule.Validate("111222333"))
+	country = "ES"
+	assert.NotNil(t, rule.Validate("111222333"))
+
+	var nilPtr *string
+	err := is.NationalID("ES").CountryFrom(nilPtr).Validate("12345678Z")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_national_id_country", err.(validation.Error).Code())
+	}
+}
+
+func TestRegisterNationalID(t *testing.T) {
+	errTest := validation.NewError("validation_test_id", "must be a valid test ID")
+	is.RegisterNationalID("xt", func(s string) bool { return s == "ABC123" }, errTest)
+
+	rule := is.NationalID("XT")
+	assert.Nil(t, rule.Validate("abc-123"))
+	assert.Equal(t, errTest, rule.Validate("ABC124"))
+}
+
+func TestMRZ(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1.1", "", true},
+		// TD3
+		{"t2.1", "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<10", true},
+		{"t2.2", "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\r\nL898902C36UTO7408122F1204159ZE184226B<<<<<10\n", true},
+		{"t2.3", "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<L898902C36UTO7408122F1204159ZE184226B<<<<<10", true},
+		{"t2.4", "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C37UTO7408122F1204159ZE184226B<<<<<10", false},
+		{"t2.5", "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<11", false},
+		{"t2.6", "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<1", false},
+		{"t2.7", "p<utoeriksson<<anna<maria<<<<<<<<<<<<<<<<<<<\nl898902c36uto7408122f1204159ze184226b<<<<<10", false},
+		// TD2
+		{"t3.1", "I<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<\nD231458907UTO7408122F1204159<<<<<<<6", true},
+		{"t3.2", "I<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<\nD231458907UTO7408122F1204158<<<<<<<6", false},
+		// TD1
+		{"t4.1", "I<UTOD231458907<<<<<<<<<<<<<<<\n7408122F1204159UTO<<<<<<<<<<<6\nERIKSSON<<ANNA<MARIA<<<<<<<<<<", true},
+		{"t4.2", "I<UTOD23145890<AB118<<<<<<<<<<\n7408122F1204159UTO<<<<<<<<<<<6\nERIKSSON<<ANNA<MARIA<<<<<<<<<<", true},
+		{"t4.3", "I<UTOD23145890<AB117<<<<<<<<<<\n7408122F1204159UTO<<<<<<<<<<<6\nERIKSSON<<ANNA<MARIA<<<<<<<<<<", false},
+		{"t4.4", "I<UTOD231458907<<<<<<<<<<<<<<<\n7408122F1204159UTO<<<<<<<<<<<7\nERIKSSON<<ANNA<MARIA<<<<<<<<<<", false},
+		{"t5.1", strings.Repeat("<", 60), false},
+	}
+	for _, test := range tests {
+		err := is.MRZ.Validate(test.value)
+		if test.valid {
+			assert.Nil(t, err, test.tag)
+		} else {
+			assert.Equal(t, is.ErrMRZ, err, test.tag)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package is
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/prodadidb/go-validation"
+)
+
+// ErrPassword is the error that returns in case of a password that does not satisfy the policy.
+// Its "failed" parameter lists the codes of the failed policy items, e.g. PasswordMinLength.
+var ErrPassword = validation.NewError("validation_is_password", "must be a stronger password")
+
+// The codes of the policy items reported in the "failed" parameter of ErrPassword.
+const (
+	PasswordMinLength   = "min_length"
+	PasswordLower       = "lower"
+	PasswordUpper       = "upper"
+	PasswordDigit       = "digit"
+	PasswordSymbol      = "symbol"
+	PasswordMaxRepeat   = "max_repeat"
+	PasswordMaxSequence = "max_sequence"
+	PasswordMinEntropy  = "min_entropy"
+	PasswordDenied      = "denied"
+)
+
+// PasswordPolicy configures the checks performed by Password. The zero value accepts any password.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters.
+	MinLength int
+	// Lower, Upper, Digit and Symbol require at least one lowercase letter, uppercase letter, digit or
+	// other character, respectively.
+	Lower, Upper, Digit, Symbol bool
+	// MaxRepeat is the maximum number of consecutive identical characters, e.g. 2 rejects "aaa".
+	// There is no limit if it is zero.
+	MaxRepeat int
+	// MaxSequence is the maximum number of consecutive ascending or descending letters or digits,
+	// e.g. 3 rejects "abcd" and "4321". Letters are compared regardless of case. There is no limit if it is zero.
+	MaxSequence int
+	// MinEntropy is the minimum estimated entropy in bits, computed as the number of characters times
+	// the base 2 logarithm of the size of the character classes used (26 lowercase letters, 26 uppercase letters,
+	// 10 digits and 33 other characters). As the estimate ignores patterns, it should be combined with other checks.
+	MinEntropy float64
+	// DenyCommon rejects the passwords in a built-in list of commonly used passwords, regardless of case.
+	DenyCommon bool
+	// DenyList lists additional passwords to reject, regardless of case, such as the name of the application.
+	DenyList []string
+}
+
+// PasswordRule is a validation rule that checks a password against a policy.
+type PasswordRule struct {
+	policy PasswordPolicy
+	denied map[string]bool
+	err    validation.Error
+}
+
+// Password returns a validation rule that checks if a string is a password satisfying the given policy.
+// All policy items are checked, and the codes of the failed ones are listed in the "failed" parameter
+// of the error, so that they can be shown as a checklist. For example,
+//
+//	is.Password(is.PasswordPolicy{MinLength: 12, Lower: true, Upper: true, Digit: true, MaxRepeat: 2, DenyCommon: true})
+//
+// The parameters "min_length", "max_repeat", "max_sequence" and "min_entropy" of the error hold the policy limits.
+func Password(policy PasswordPolicy) PasswordRule {
+	denied := make(map[string]bool, len(policy.DenyList))
+	for _, p := range policy.DenyList {
+		denied[strings.ToLower(p)] = true
+	}
+	return PasswordRule{policy: policy, denied: denied, err: ErrPassword}
+}
+
+// Error sets the error message for the rule.
+func (r PasswordRule) Error(message string) PasswordRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PasswordRule) ErrorObject(err validation.Error) PasswordRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r PasswordRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+	if failed := r.check(str); len(failed) > 0 {
+		p := r.policy
+		return r.err.SetParams(map[string]interface{}{
+			"failed":       failed,
+			"min_length":   p.MinLength,
+			"max_repeat":   p.MaxRepeat,
+			"max_sequence": p.MaxSequence,
+			"min_entropy":  p.MinEntropy,
+		})
+	}
+	return nil
+}
+
+// check returns the codes of the policy items the password fails.
+func (r PasswordRule) check(password string) []string {
+	p := r.policy
+	runes := []rune(password)
+	var lower, upper, digit, symbol bool
+	for _, c := range runes {
+		switch {
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsDigit(c):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	var failed []string
+	add := func(fails bool, code string) {
+		if fails {
+			failed = append(failed, code)
+		}
+	}
+	add(len(runes) < p.MinLength, PasswordMinLength)
+	add(p.Lower && !lower, PasswordLower)
+	add(p.Upper && !upper, PasswordUpper)
+	add(p.Digit && !digit, PasswordDigit)
+	add(p.Symbol && !symbol, PasswordSymbol)
+	add(p.MaxRepeat > 0 && longestRepeat(runes) > p.MaxRepeat, PasswordMaxRepeat)
+	add(p.MaxSequence > 0 && longestSequence(runes) > p.MaxSequence, PasswordMaxSequence)
+	add(p.MinEntropy > 0 && passwordEntropy(len(runes), lower, upper, digit, symbol) < p.MinEntropy, PasswordMinEntropy)
+	lowered := strings.ToLower(password)
+	add(p.DenyCommon && commonPasswords[lowered] || r.denied[lowered], PasswordDenied)
+	return failed
+}
+
+// longestRepeat returns the length of the longest run of identical characters.
+func longestRepeat(runes []rune) int {
+	longest, n := 0, 0
+	for i, c := range runes {
+		if i > 0 && c == runes[i-1] {
+			n++
+		} else {
+			n = 1
+		}
+		if n > longest {
+			longest = n
+		}
+	}
+	return longest
+}
+
+// longestSequence returns the length of the longest run of ascending or descending letters or digits.
+func longestSequence(runes []rune) int {
+	longest, n, step := 0, 0, rune(0)
+	for i, c := range runes {
+		c = unicode.ToLower(c)
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			n, step = 0, 0
+			continue
+		}
+		d := rune(0)
+		if n > 0 {
+			d = c - unicode.ToLower(runes[i-1])
+		}
+		switch {
+		case n > 0 && (d == 1 || d == -1) && (n == 1 || d == step):
+			n++
+		case n > 0 && (d == 1 || d == -1):
+			// the direction changes, e.g. "aba"
+			n = 2
+		default:
+			n = 1
+		}
+		step = d
+		if n > longest {
+			longest = n
+		}
+	}
+	return longest
+}
+
+// passwordEntropy estimates the entropy in bits of a password of the given length using the given character classes.
+func passwordEntropy(length int, lower, upper, digit, symbol bool) float64 {
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(length) * math.Log2(float64(pool))
+}
+
+// commonPasswords lists commonly used passwords.
+var commonPasswords = func() map[string]bool {
+	list := []string{
+		"000000", "111111", "11111111", "112233", "121212", "123123", "123321", "1234", "12345", "123456",
+		"1234567", "12345678", "123456789", "1234567890", "123qwe", "131313", "159753", "1q2w3e4r", "1q2w3e4r5t",
+		"1qaz2wsx", "555555", "654321", "666666", "696969", "7777777", "777777", "987654321", "aaaaaa", "abc123",
+		"access", "admin", "admin123", "amanda", "andrew", "ashley", "asdfgh", "asdfghjkl", "austin", "baseball",
+		"batman", "buster", "charlie", "cheese", "chelsea", "computer", "dallas", "daniel", "dragon", "football",
+		"freedom", "george", "ginger", "hannah", "harley", "hello", "hockey", "hunter", "iloveyou", "jennifer",
+		"jessica", "jordan", "joshua", "killer", "letmein", "login", "love", "maggie", "master", "matrix",
+		"matthew", "michael", "michelle", "monkey", "mustang", "nicole", "p@ssw0rd", "pass", "passw0rd",
+		"password", "password1", "password123", "pepper", "princess", "qazwsx", "qwerty", "qwerty123",
+		"qwertyuiop", "ranger", "robert", "shadow", "soccer", "starwars", "summer", "sunshine", "superman",
+		"taylor", "thomas", "thunder", "tigger", "trustno1", "welcome", "welcome1", "yankees", "zxcvbn", "zxcvbnm",
+	}
+	m := make(map[string]bool, len(list))
+	for _, p := range list {
+		m[p] = true
+	}
+	return m
+}()
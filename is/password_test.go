@@ -0,0 +1,81 @@
+package is_test
+
+import (
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassword(t *testing.T) {
+	strict := is.Password(is.PasswordPolicy{
+		MinLength:   10,
+		Lower:       true,
+		Upper:       true,
+		Digit:       true,
+		Symbol:      true,
+		MaxRepeat:   2,
+		MaxSequence: 3,
+		MinEntropy:  60,
+		DenyCommon:  true,
+		DenyList:    []string{"Acme-Corp-2024!"},
+	})
+
+	tests := []struct {
+		tag    string
+		rule   validation.Rule
+		value  interface{}
+		failed []string
+	}{
+		{"t1.1", strict, "", nil},
+		{"t1.2", strict, "Tr0ub4dor&3x", nil},
+		{"t1.3", strict, "Tr0ub&3x", []string{is.PasswordMinLength, is.PasswordMinEntropy}},
+		{"t1.4", strict, "tr0ub4dor&3x", []string{is.PasswordUpper}},
+		{"t1.5", strict, "TR0UB4DOR&3X", []string{is.PasswordLower}},
+		{"t1.6", strict, "Troubadour&x", []string{is.PasswordDigit}},
+		{"t1.7", strict, "Tr0ub4dor33x", []string{is.PasswordSymbol}},
+		{"t1.8", strict, "Tr0ub4dooor&3x", []string{is.PasswordMaxRepeat}},
+		{"t1.9", strict, "Tr0ub4dor&wxyz1", []string{is.PasswordMaxSequence}},
+		{"t1.10", strict, "Tr0ub4dor&9876", []string{is.PasswordMaxSequence}},
+		{"t1.11", strict, "Tr0ub4dor&3WxYz", []string{is.PasswordMaxSequence}},
+		{"t1.12", strict, "acme-corp-2024!", []string{is.PasswordUpper, is.PasswordDenied}},
+		{"t1.13", strict, "password", []string{
+			is.PasswordMinLength, is.PasswordUpper, is.PasswordDigit, is.PasswordSymbol, is.PasswordMinEntropy, is.PasswordDenied,
+		}},
+		{"t1.14", strict, []byte("Tr0ub4dor&3x"), nil},
+		{"t2.1", is.Password(is.PasswordPolicy{}), "a", nil},
+		{"t2.2", is.Password(is.PasswordPolicy{MaxSequence: 3}), "abab-aba-121", nil},
+		{"t2.3", is.Password(is.PasswordPolicy{MaxRepeat: 2}), "ééé", []string{is.PasswordMaxRepeat}},
+		{"t2.4", is.Password(is.PasswordPolicy{DenyCommon: true}), "QWERTY", []string{is.PasswordDenied}},
+		{"t2.5", is.Password(is.PasswordPolicy{MinLength: 4}), "ñãõü", nil},
+		{"t2.6", is.Password(is.PasswordPolicy{MinEntropy: 28}), "abcdef", nil},
+		{"t2.7", is.Password(is.PasswordPolicy{MinEntropy: 29}), "abcdef", []string{is.PasswordMinEntropy}},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		if test.failed == nil {
+			assert.Nil(t, err, test.tag)
+		} else if assert.NotNil(t, err, test.tag) {
+			e := err.(validation.Error)
+			assert.Equal(t, "validation_is_password", e.Code(), test.tag)
+			assert.Equal(t, test.failed, e.Params()["failed"], test.tag)
+		}
+	}
+
+	err := strict.Validate("Tr0ub&3x")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "must be a stronger password", err.Error())
+		assert.Equal(t, 10, err.(validation.Error).Params()["min_length"])
+	}
+	err = strict.Validate(123)
+	assert.EqualError(t, err, "must be either a string or byte slice")
+
+	err = strict.Error("too weak").Validate("password")
+	assert.EqualError(t, err, "too weak")
+	err = strict.ErrorObject(validation.NewError("code", "abc")).Validate("password")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "code", err.(validation.Error).Code())
+		assert.Len(t, err.(validation.Error).Params()["failed"], 6)
+	}
+}
@@ -0,0 +1,146 @@
+package is
+
+import (
+	"regexp"
+
+	"github.com/prodadidb/go-validation"
+)
+
+var (
+	// ErrGTIN is the error that returns in case of an invalid GTIN.
+	ErrGTIN = validation.NewError("validation_is_gtin", "must be a valid GTIN")
+	// ErrEAN13 is the error that returns in case of an invalid EAN-13 value.
+	ErrEAN13 = validation.NewError("validation_is_ean13", "must be a valid EAN-13")
+	// ErrUPCA is the error that returns in case of an invalid UPC-A value.
+	ErrUPCA = validation.NewError("validation_is_upca", "must be a valid UPC-A")
+	// ErrISSN is the error that returns in case of an invalid ISSN.
+	ErrISSN = validation.NewError("validation_is_issn", "must be a valid ISSN")
+	// ErrVIN is the error that returns in case of an invalid vehicle identification number.
+	ErrVIN = validation.NewError("validation_is_vin", "must be a valid vehicle identification number")
+	// ErrContainerCode is the error that returns in case of an invalid ISO 6346 container code.
+	ErrContainerCode = validation.NewError("validation_is_container_code", "must be a valid container code")
+)
+
+var (
+	// GTIN validates if a string is a valid Global Trade Item Number of 8, 12, 13 or 14 digits (GTIN-8, GTIN-12,
+	// GTIN-13 or GTIN-14), including its check digit. Hyphens and white spaces are ignored.
+	GTIN = validation.NewStringRuleWithError(isGTIN, ErrGTIN)
+	// EAN13 validates if a string is a valid EAN-13 barcode number, including its check digit
+	EAN13 = validation.NewStringRuleWithError(isGTINOfLength(13), ErrEAN13)
+	// UPCA validates if a string is a valid UPC-A barcode number, including its check digit
+	UPCA = validation.NewStringRuleWithError(isGTINOfLength(12), ErrUPCA)
+	// ISSN validates if a string is a valid International Standard Serial Number, such as "0317-8471"
+	ISSN = validation.NewStringRuleWithError(isISSN, ErrISSN)
+	// VIN validates if a string is a valid vehicle identification number (ISO 3779), including the check digit
+	// in the ninth position. As the check digit is only mandatory in North America, use VINFormat to validate
+	// the vehicle identification numbers of other markets.
+	VIN = validation.NewStringRuleWithError(isVIN, ErrVIN)
+	// VINFormat validates if a string is in the format of a vehicle identification number (ISO 3779).
+	// It does NOT check the check digit.
+	VINFormat = validation.NewStringRuleWithError(isVINFormat, ErrVIN)
+	// ContainerCode validates if a string is a valid shipping container code (ISO 6346), such as "CSQU 305438 3",
+	// including its check digit
+	ContainerCode = validation.NewStringRuleWithError(isContainerCode, ErrContainerCode)
+)
+
+var (
+	reSeparators    = regexp.MustCompile(`[\s-]+`)
+	reGTIN          = regexp.MustCompile(`^(?:[0-9]{8}|[0-9]{12,14})$`)
+	reISSN          = regexp.MustCompile(`^[0-9]{7}[0-9X]$`)
+	reVIN           = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+	reContainerCode = regexp.MustCompile(`^[A-Z]{3}[UJZ][0-9]{7}$`)
+
+	// vinValues maps the letters of vehicle identification numbers to their values.
+	vinValues = map[byte]int{
+		'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8, 'J': 1, 'K': 2, 'L': 3, 'M': 4,
+		'N': 5, 'P': 7, 'R': 9, 'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+	}
+	vinWeights = [...]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+func isGTIN(value string) bool {
+	value = stripSeparators(value)
+	return reGTIN.MatchString(value) && gs1CheckDigit(value)
+}
+
+func isGTINOfLength(length int) func(string) bool {
+	return func(value string) bool {
+		value = stripSeparators(value)
+		return len(value) == length && reGTIN.MatchString(value) && gs1CheckDigit(value)
+	}
+}
+
+func isISSN(value string) bool {
+	value = stripSeparators(value)
+	if !reISSN.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 7; i++ {
+		sum += int(value[i]-'0') * (8 - i)
+	}
+	return checkDigit11((11-sum%11)%11) == value[7]
+}
+
+func isVIN(value string) bool {
+	value = stripSeparators(value)
+	if !reVIN.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(value); i++ {
+		v, ok := vinValues[value[i]]
+		if !ok {
+			v = int(value[i] - '0')
+		}
+		sum += v * vinWeights[i]
+	}
+	return checkDigit11(sum%11) == value[8]
+}
+
+func isVINFormat(value string) bool {
+	return reVIN.MatchString(stripSeparators(value))
+}
+
+func isContainerCode(value string) bool {
+	value = stripSeparators(value)
+	if !reContainerCode.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		v := alnumValue(rune(value[i]))
+		if v >= 10 {
+			// the letter values skip the multiples of 11
+			v += (v - 1) / 10
+		}
+		sum += v << i
+	}
+	return sum%11%10 == int(value[10]-'0')
+}
+
+// gs1CheckDigit checks the last digit of a GS1 identification number, such as a GTIN.
+func gs1CheckDigit(digits string) bool {
+	sum := 0
+	for i := len(digits) - 2; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(digits[len(digits)-1]-'0')
+}
+
+// checkDigit11 returns the character of a modulo 11 check digit, where 10 is represented by "X".
+func checkDigit11(d int) byte {
+	if d == 10 {
+		return 'X'
+	}
+	return byte('0' + d)
+}
+
+// stripSeparators removes the hyphens and white spaces of a string.
+func stripSeparators(value string) string {
+	return reSeparators.ReplaceAllString(value, "")
+}
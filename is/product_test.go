@@ -0,0 +1,74 @@
+package is_test
+
+import (
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductIdentifiers(t *testing.T) {
+	tests := []struct {
+		tag     string
+		rule    validation.Rule
+		valid   []string
+		invalid []string
+		code    string
+	}{
+		{
+			"GTIN", is.GTIN,
+			[]string{"96385074", "036000291452", "4006381333931", "10012345678902", "400-6381-33393-1", "0 36000 29145 2"},
+			[]string{"96385075", "4006381333932", "400638133393", "123456789", "400638133393A", "100123456789021"},
+			"validation_is_gtin",
+		},
+		{
+			"EAN13", is.EAN13,
+			[]string{"4006381333931", "9780306406157", "978-0-306-40615-7", "4 006381 333931"},
+			[]string{"4006381333932", "036000291452", "96385074"},
+			"validation_is_ean13",
+		},
+		{
+			"UPCA", is.UPCA,
+			[]string{"036000291452", "0-36000-29145-2"},
+			[]string{"036000291453", "4006381333931", "96385074"},
+			"validation_is_upca",
+		},
+		{
+			"ISSN", is.ISSN,
+			[]string{"0317-8471", "2049-3630", "03785955", "1050-124X", "0000 006X"},
+			[]string{"0317-8472", "0317-847", "1050-124x", "2049-363X"},
+			"validation_is_issn",
+		},
+		{
+			"VIN", is.VIN,
+			[]string{"1M8GDM9AXKP042788", "1HGCM82633A004352", "11111111111111111", "1M8-GDM9AX-KP042788"},
+			[]string{"1M8GDM9AXKP042789", "WP0ZZZ99ZTS392124", "1M8GDM9AXKP04278", "IM8GDM9AXKP042788", "1m8gdm9axkp042788"},
+			"validation_is_vin",
+		},
+		{
+			"VINFormat", is.VINFormat,
+			[]string{"WP0ZZZ99ZTS392124", "1M8GDM9AXKP042789"},
+			[]string{"WP0ZZZ99ZTS39212", "WP0ZZZ99ZTS39212O"},
+			"validation_is_vin",
+		},
+		{
+			"ContainerCode", is.ContainerCode,
+			[]string{"CSQU3054383", "CSQU 305438 3", "CSQU-305438-3", "MSKU9070323", "TOLU4734787"},
+			[]string{"CSQU3054384", "CSQA3054383", "CSQU305438", "csqu3054383"},
+			"validation_is_container_code",
+		},
+	}
+	for _, test := range tests {
+		assert.Nil(t, test.rule.Validate(""), test.tag)
+		for _, value := range test.valid {
+			assert.Nil(t, test.rule.Validate(value), test.tag+" "+value)
+		}
+		for _, value := range test.invalid {
+			err := test.rule.Validate(value)
+			if assert.NotNil(t, err, test.tag+" "+value) {
+				assert.Equal(t, test.code, err.(validation.Error).Code(), test.tag+" "+value)
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package is
 
 import (
+	"net"
+	"net/netip"
 	"regexp"
 	"unicode"
 
@@ -121,6 +123,7 @@ var (
 
 var (
 	// Email validates if a string is an email or not. It also checks if the MX record exists for the email domain.
+	// Use EmailWith to control the lookup with a context, a timeout or a custom resolver.
 	Email = validation.NewStringRuleWithError(utils.IsExistingEmail, ErrEmail)
 	// EmailFormat validates if a string is an email or not. Note that it does NOT check if the MX record exists or not.
 	EmailFormat = validation.NewStringRuleWithError(utils.IsEmail, ErrEmail)
@@ -204,12 +207,21 @@ var (
 	DialString = validation.NewStringRuleWithError(utils.IsDialString, ErrDialString)
 	// MAC validates if a string is a MAC address
 	MAC = validation.NewStringRuleWithError(utils.IsMAC, ErrMac)
-	// IP validates if a string is a valid IP address (either version 4 or 6)
-	IP = validation.NewStringRuleWithError(utils.IsIP, ErrIP)
-	// IPv4 validates if a string is a valid version 4 IP address
-	IPv4 = validation.NewStringRuleWithError(utils.IsIPv4, ErrIPv4)
-	// IPv6 validates if a string is a valid version 6 IP address
-	IPv6 = validation.NewStringRuleWithError(utils.IsIPv6, ErrIPv6)
+	// IP validates if a string, net.IP or netip.Addr is a valid IP address (either version 4 or 6)
+	IP = validation.NewStringRuleWithError(utils.IsIP, ErrIP).WithNative(nativeIP(
+		func(ip net.IP) bool { return len(ip) == net.IPv4len || len(ip) == net.IPv6len },
+		netip.Addr.IsValid,
+	))
+	// IPv4 validates if a string, net.IP or netip.Addr is a valid version 4 IP address
+	IPv4 = validation.NewStringRuleWithError(utils.IsIPv4, ErrIPv4).WithNative(nativeIP(
+		func(ip net.IP) bool { return ip.To4() != nil },
+		func(ip netip.Addr) bool { return ip.Is4() || ip.Is4In6() },
+	))
+	// IPv6 validates if a string, net.IP or netip.Addr is a valid version 6 IP address
+	IPv6 = validation.NewStringRuleWithError(utils.IsIPv6, ErrIPv6).WithNative(nativeIP(
+		func(ip net.IP) bool { return len(ip) == net.IPv6len && ip.To4() == nil },
+		netip.Addr.Is6,
+	))
 	// Subdomain validates if a string is valid subdomain
 	Subdomain = validation.NewStringRuleWithError(isSubdomain, ErrSubdomain)
 	// Domain validates if a string is valid domain
@@ -218,6 +230,13 @@ var (
 	DNSName = validation.NewStringRuleWithError(utils.IsDNSName, ErrDNSName)
 	// Host validates if a string is a valid IP (both v4 and v6) or a valid DNS name
 	Host = validation.NewStringRuleWithError(utils.IsHost, ErrHost)
+	// IDNDomain validates if a string is a valid domain, allowing internationalized labels such as "bücher.de".
+	// The name is converted to its ASCII form with utils.ToASCII before it is checked like Domain.
+	IDNDomain = validation.NewStringRuleWithError(idna(isDomain), ErrDomain)
+	// IDNDNSName validates if a string is a valid DNS name, allowing internationalized labels
+	IDNDNSName = validation.NewStringRuleWithError(idna(utils.IsDNSName), ErrDNSName)
+	// IDNHost validates if a string is a valid IP (both v4 and v6) or a valid DNS name, allowing internationalized labels
+	IDNHost = validation.NewStringRuleWithError(idna(utils.IsHost), ErrHost)
 	// Port validates if a string is a valid port number
 	Port = validation.NewStringRuleWithError(utils.IsPort, ErrPort)
 	// MongoID validates if a string is a valid Mongo ID
@@ -241,7 +260,7 @@ var (
 	// Domain regex source: https://stackoverflow.com/a/7933253
 	// Slightly modified: Removed 255 max length validation since Go regex does not
 	// support lookarounds. More info: https://stackoverflow.com/a/38935027
-	reDomain = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-z0-9])?\.)+(?:[a-zA-Z]{1,63}| xn--[a-z0-9]{1,59})$`)
+	reDomain = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-z0-9])?\.)+(?:[a-zA-Z]{1,63}|xn--[a-zA-Z0-9-]{1,59})$`)
 )
 
 func isISBN(value string) bool {
@@ -268,6 +287,14 @@ func isDomain(value string) bool {
 	return reDomain.MatchString(value)
 }
 
+// idna returns a check that converts an internationalized domain name to its ASCII form before applying check.
+func idna(check func(string) bool) func(string) bool {
+	return func(value string) bool {
+		ascii, ok := utils.ToASCII(value)
+		return ok && check(ascii)
+	}
+}
+
 func isUTFNumeric(value string) bool {
 	for _, c := range value {
 		if !unicode.IsNumber(c) {
@@ -276,3 +303,16 @@ func isUTFNumeric(value string) bool {
 	}
 	return true
 }
+
+// nativeIP returns a validator checking net.IP and netip.Addr values with the given functions.
+func nativeIP(checkIP func(net.IP) bool, checkAddr func(netip.Addr) bool) validation.NativeValidator {
+	return func(value interface{}) (bool, bool) {
+		switch v := value.(type) {
+		case net.IP:
+			return checkIP(v), true
+		case netip.Addr:
+			return checkAddr(v), true
+		}
+		return false, false
+	}
+}
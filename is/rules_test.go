@@ -1,6 +1,9 @@
 package is_test
 
 import (
+	"net"
+	"net/netip"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -37,8 +40,15 @@ func TestAll(t *testing.T) {
 		{"Subdomain", is.Subdomain, "example-subdomain", "example.com", "must be a valid subdomain"},
 		{"Domain", is.Domain, "example-domain.com", "localhost", "must be a valid domain"},
 		{"Domain", is.Domain, "example-domain.com", strings.Repeat("a", 256), "must be a valid domain"},
+		{"Domain", is.Domain, "xn--bcher-kva.xn--p1ai", "bücher.de", "must be a valid domain"},
 		{"DNSName", is.DNSName, "example.com", "abc%", "must be a valid DNS name"},
 		{"Host", is.Host, "example.com", "abc%", "must be a valid IP address or DNS name"},
+		{"IDNDomain", is.IDNDomain, "bücher.de", "bücher", "must be a valid domain"},
+		{"IDNDomain", is.IDNDomain, "пример.рф", "xn--bcher.de", "must be a valid domain"},
+		{"IDNDomain", is.IDNDomain, "xn--bcher-kva.de", strings.Repeat("ü", 64) + ".de", "must be a valid domain"},
+		{"IDNDNSName", is.IDNDNSName, "例子。测试", "bü%cher.de", "must be a valid DNS name"},
+		{"IDNHost", is.IDNHost, "Bücher.de", "bücher!.de", "must be a valid IP address or DNS name"},
+		{"IDNHost", is.IDNHost, "2001:4860:0:2001::68", "bü cher.de", "must be a valid IP address or DNS name"},
 		{"Port", is.Port, "123", "99999", "must be a valid port number"},
 		{"Latitude", is.Latitude, "23.123", "100", "must be a valid latitude"},
 		{"Longitude", is.Longitude, "123.123", "abc", "must be a valid longitude"},
@@ -96,3 +106,42 @@ func assertError(t *testing.T, expected string, err error, tag string) {
 		assert.Equal(t, expected, err.Error(), tag)
 	}
 }
+
+func TestNativeIP(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		valid bool
+	}{
+		{"t1.1", is.IP, net.ParseIP("74.125.19.99"), true},
+		{"t1.2", is.IP, net.IP{1, 2, 3}, false},
+		{"t1.3", is.IP, netip.MustParseAddr("2001:4860:0:2001::68"), true},
+		{"t1.4", is.IP, netip.Addr{}, true},
+		{"t1.5", is.IP, net.IP(nil), true},
+		{"t2.1", is.IPv4, net.ParseIP("74.125.19.99"), true},
+		{"t2.2", is.IPv4, net.IPv4(74, 125, 19, 99).To4(), true},
+		{"t2.3", is.IPv4, net.ParseIP("2001:4860:0:2001::68"), false},
+		{"t2.4", is.IPv4, netip.MustParseAddr("74.125.19.99"), true},
+		{"t2.5", is.IPv4, netip.MustParseAddr("::ffff:74.125.19.99"), true},
+		{"t2.6", is.IPv4, netip.MustParseAddr("2001:4860:0:2001::68"), false},
+		{"t3.1", is.IPv6, net.ParseIP("2001:4860:0:2001::68"), true},
+		{"t3.2", is.IPv6, net.ParseIP("74.125.19.99"), false},
+		{"t3.3", is.IPv6, netip.MustParseAddr("2001:4860:0:2001::68"), true},
+		{"t3.4", is.IPv6, netip.MustParseAddr("74.125.19.99"), false},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assert.Equal(t, test.valid, err == nil, test.tag)
+	}
+}
+
+func TestAllowText(t *testing.T) {
+	u, _ := url.Parse("http://example.com")
+
+	assert.NotNil(t, is.URL.Validate(*u))
+	assert.Nil(t, is.URL.AllowText().Validate(*u))
+	assert.Nil(t, is.URL.AllowText().Validate(u))
+	assert.Equal(t, is.ErrURL, is.URL.AllowText().Validate(url.URL{Path: "examplecom"}))
+	assert.Nil(t, is.IP.AllowText().Validate(netip.MustParseAddr("74.125.19.99")))
+}
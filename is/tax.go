@@ -0,0 +1,655 @@
+package is
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is/utils"
+)
+
+var (
+	// ErrVATNumber is the error that returns in case of an invalid VAT number.
+	ErrVATNumber = validation.NewError("validation_is_vat_number", "must be a valid VAT number")
+	// ErrTaxID is the error that returns in case of an invalid tax identification number.
+	ErrTaxID = validation.NewError("validation_is_tax_id", "must be a valid tax identification number")
+	// ErrTaxCountry is the error that returns in case of a tax number whose country is unknown or not supported.
+	// Its "country" parameter holds the country.
+	ErrTaxCountry = validation.NewError("validation_is_tax_country", "cannot be validated for the given country")
+)
+
+// TaxRule is a validation rule that checks a tax number according to the format of a country.
+type TaxRule struct {
+	country    string
+	countryPtr interface{}
+	checks     map[string]func(string) bool
+	prefixes   map[string]string
+	err        validation.Error
+}
+
+// VATNumber returns a validation rule that checks if a string is a valid VAT number of the given country,
+// identified by its ISO 3166 alpha-2 code, including its check digits where they exist.
+// The supported countries are the member states of the European Union, the United Kingdom ("GB"),
+// Australia ("AU", whose ABN is used for GST) and India ("IN", whose GSTIN is used for GST).
+// The number may start with the VAT prefix of an EU member state or the United Kingdom, e.g. "DE136695976"
+// or "EL094259216" for Greece, and white spaces, dots, hyphens and slashes are ignored, as well as the case of letters.
+// The country may also be taken from another field with CountryFrom. For example,
+//
+//	validation.Field(&m.VAT, is.VATNumber("").CountryFrom(&m.Country))
+//
+// If the country is not supported, ErrTaxCountry is returned.
+func VATNumber(country string) TaxRule {
+	return TaxRule{country: country, checks: vatNumbers, prefixes: vatPrefixes, err: ErrVATNumber}
+}
+
+// TaxID returns a validation rule that checks if a string is a valid tax identification number of the given
+// country, identified by its ISO 3166 alpha-2 code, including its check digits.
+// The supported countries and numbers are Brazil ("BR", either a CPF or a CNPJ), Mexico ("MX", an RFC),
+// India ("IN", a GSTIN) and Australia ("AU", an ABN). As with VATNumber, separators and the case of letters
+// are ignored, and the country may be taken from another field with CountryFrom.
+// If the country is not supported, ErrTaxCountry is returned.
+func TaxID(country string) TaxRule {
+	return TaxRule{country: country, checks: taxIDs, err: ErrTaxID}
+}
+
+// CountryFrom configures the rule to take the country from the given pointer when validating,
+// such as a pointer to another field of the struct being validated. The country given to the rule is ignored.
+func (r TaxRule) CountryFrom(fieldPtr interface{}) TaxRule {
+	r.countryPtr = fieldPtr
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r TaxRule) Error(message string) TaxRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r TaxRule) ErrorObject(err validation.Error) TaxRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r TaxRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	country := r.country
+	if r.countryPtr != nil {
+		country = ""
+		if v, isNil := validation.Indirect(r.countryPtr); !isNil {
+			country, _ = validation.EnsureString(v)
+		}
+	}
+	country = strings.ToUpper(country)
+	check, ok := r.checks[country]
+	if !ok || !utils.IsISO3166Alpha2(country) {
+		return ErrTaxCountry.SetParams(map[string]interface{}{"country": country})
+	}
+
+	str = strings.ToUpper(reTaxSeparators.ReplaceAllString(str, ""))
+	if prefix, ok := r.prefixes[country]; ok {
+		str = strings.TrimPrefix(str, prefix)
+	}
+	if !check(str) {
+		return r.err
+	}
+	return nil
+}
+
+var (
+	reTaxSeparators = regexp.MustCompile(`[\s./-]+`)
+
+	// vatNumbers maps countries to the checks of their VAT numbers, without the VAT prefix.
+	vatNumbers = map[string]func(string) bool{
+		"AT": isVATAT, "BE": isVATBE, "BG": isVATBG, "CY": isVATCY, "CZ": isVATCZ, "DE": isVATDE, "DK": isVATDK,
+		"EE": isVATEE, "ES": isVATES, "FI": isVATFI, "FR": isVATFR, "GR": isVATGR, "HR": isVATHR, "HU": isVATHU,
+		"IE": isVATIE, "IT": isVATIT, "LT": isVATLT, "LU": isVATLU, "LV": isVATLV, "MT": isVATMT, "NL": isVATNL,
+		"PL": isVATPL, "PT": isVATPT, "RO": isVATRO, "SE": isVATSE, "SI": isVATSI, "SK": isVATSK,
+		"GB": isVATGB, "AU": isABN, "IN": isGSTIN,
+	}
+
+	// vatPrefixes maps countries to the prefixes of their VAT numbers.
+	vatPrefixes = func() map[string]string {
+		prefixes := map[string]string{"GR": "EL"}
+		for country := range vatNumbers {
+			if country != "GR" && country != "AU" && country != "IN" {
+				prefixes[country] = country
+			}
+		}
+		return prefixes
+	}()
+
+	// taxIDs maps countries to the checks of their tax identification numbers.
+	taxIDs = map[string]func(string) bool{
+		"BR": func(s string) bool { return isCPF(s) || isCNPJ(s) },
+		"MX": isRFC,
+		"IN": isGSTIN,
+		"AU": isABN,
+	}
+)
+
+var (
+	reDigits8   = regexp.MustCompile(`^[0-9]{8}$`)
+	reDigits9   = regexp.MustCompile(`^[0-9]{9}$`)
+	reDigits10  = regexp.MustCompile(`^[0-9]{10}$`)
+	reDigits11  = regexp.MustCompile(`^[0-9]{11}$`)
+	reVATAT     = regexp.MustCompile(`^U[0-9]{8}$`)
+	reVATBE     = regexp.MustCompile(`^[01]?[0-9]{9}$`)
+	reVATBG     = regexp.MustCompile(`^[0-9]{9,10}$`)
+	reVATCY     = regexp.MustCompile(`^[013-59][0-9]{7}[A-Z]$`)
+	reVATCZ     = regexp.MustCompile(`^[0-9]{8,10}$`)
+	reVATEE     = regexp.MustCompile(`^10[0-9]{7}$`)
+	reVATFR     = regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}[0-9]{9}$`)
+	reVATIE     = regexp.MustCompile(`^[0-9]{7}[A-W][A-IW]?$`)
+	reVATIEOld  = regexp.MustCompile(`^[0-9][A-Z+*][0-9]{5}[A-W]$`)
+	reVATLT     = regexp.MustCompile(`^(?:[0-9]{7}1[0-9]|[0-9]{10}1[0-9])$`)
+	reVATNL     = regexp.MustCompile(`^[0-9]{9}B[0-9]{2}$`)
+	reVATRO     = regexp.MustCompile(`^[1-9][0-9]{1,9}$`)
+	reVATSE     = regexp.MustCompile(`^[0-9]{10}01$`)
+	reVATSK     = regexp.MustCompile(`^[1-9][0-9][2-47-9][0-9]{7}$`)
+	reVATGB     = regexp.MustCompile(`^(?:[0-9]{9}|[0-9]{12}|GD[0-4][0-9]{2}|HA[5-9][0-9]{2})$`)
+	reDNI       = regexp.MustCompile(`^[0-9]{8}[A-Z]$`)
+	reNIE       = regexp.MustCompile(`^[XYZ][0-9]{7}[A-Z]$`)
+	reCIF       = regexp.MustCompile(`^[ABCDEFGHJNPQRSUVW][0-9]{7}[0-9A-J]$`)
+	reNIFKLM    = regexp.MustCompile(`^[KLM][0-9]{7}[A-Z]$`)
+	reGSTIN     = regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][1-9A-Z][0-9A-Z][0-9A-Z]$`)
+	reCNPJ      = regexp.MustCompile(`^[0-9A-Z]{12}[0-9]{2}$`)
+	reRFC       = regexp.MustCompile(`^[A-ZÑ&]{3,4}[0-9]{6}[0-9A-Z]{2}[0-9A]$`)
+	dniLetters  = "TRWAGMYFPDXBNJZSQVHLCKE"
+	rfcAlphabet = []rune("0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ")
+)
+
+func isVATAT(s string) bool {
+	if !reVATAT.MatchString(s) {
+		return false
+	}
+	sum := 0
+	for i, c := range s[1:8] {
+		d := int(c - '0')
+		if i%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	return (10-(sum+4)%10)%10 == digit(s, 8)
+}
+
+func isVATBE(s string) bool {
+	if !reVATBE.MatchString(s) {
+		return false
+	}
+	if len(s) == 9 {
+		s = "0" + s
+	}
+	n, _ := strconv.Atoi(s[:8])
+	check, _ := strconv.Atoi(s[8:])
+	return 97-n%97 == check
+}
+
+func isVATBG(s string) bool {
+	if !reVATBG.MatchString(s) {
+		return false
+	}
+	if len(s) == 9 {
+		r := weightedSum(s[:8], 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if r == 10 {
+			if r = weightedSum(s[:8], 3, 4, 5, 6, 7, 8, 9, 10) % 11; r == 10 {
+				r = 0
+			}
+		}
+		return r == digit(s, 8)
+	}
+	// a personal number, the number of a foreigner or another number
+	if r := weightedSum(s[:9], 2, 4, 8, 5, 10, 9, 7, 3, 6) % 11 % 10; r == digit(s, 9) && isBulgarianBirthDate(s[:6]) {
+		return true
+	}
+	if weightedSum(s[:9], 21, 19, 17, 13, 11, 9, 7, 3, 1)%10 == digit(s, 9) {
+		return true
+	}
+	r := 11 - weightedSum(s[:9], 4, 3, 2, 7, 6, 5, 4, 3, 2)%11
+	return r != 10 && r%11 == digit(s, 9)
+}
+
+func isVATCY(s string) bool {
+	if !reVATCY.MatchString(s) || strings.HasPrefix(s, "12") {
+		return false
+	}
+	odd := [...]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			sum += odd[digit(s, i)]
+		} else {
+			sum += digit(s, i)
+		}
+	}
+	return byte('A'+sum%26) == s[8]
+}
+
+func isVATCZ(s string) bool {
+	if !reVATCZ.MatchString(s) {
+		return false
+	}
+	switch len(s) {
+	case 8:
+		// a legal entity
+		if s[0] == '9' {
+			return false
+		}
+		r := 11 - weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)%11
+		return r%10 == digit(s, 7)
+	case 10:
+		// a personal number, which is divisible by 11
+		n, _ := strconv.ParseInt(s, 10, 64)
+		return n%11 == 0
+	}
+	// a personal number of someone born before 1954, without check digit
+	return true
+}
+
+func isVATDE(s string) bool {
+	return reDigits9.MatchString(s) && s[0] != '0' && mod11_10(s)
+}
+
+func isVATDK(s string) bool {
+	return reDigits8.MatchString(s) && s[0] != '0' && weightedSum(s, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func isVATEE(s string) bool {
+	return reVATEE.MatchString(s) && (10-weightedSum(s[:8], 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 == digit(s, 8)
+}
+
+func isVATES(s string) bool {
+	return isDNI(s) || isNIE(s) || isCIF(s) || isNIFKLM(s)
+}
+
+func isVATFI(s string) bool {
+	if !reDigits8.MatchString(s) {
+		return false
+	}
+	r := 11 - weightedSum(s[:7], 7, 9, 10, 5, 8, 4, 2)%11
+	return r != 10 && r%11 == digit(s, 7)
+}
+
+func isVATFR(s string) bool {
+	if !reVATFR.MatchString(s) {
+		return false
+	}
+	key, err := strconv.Atoi(s[:2])
+	if err != nil {
+		// the keys with letters have no public check
+		return true
+	}
+	siren, _ := strconv.Atoi(s[2:])
+	return (12+3*(siren%97))%97 == key
+}
+
+func isVATGR(s string) bool {
+	return reDigits9.MatchString(s) && weightedSum(s[:8], 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == digit(s, 8)
+}
+
+func isVATHR(s string) bool {
+	return reDigits11.MatchString(s) && mod11_10(s)
+}
+
+func isVATHU(s string) bool {
+	return reDigits8.MatchString(s) && (10-weightedSum(s[:7], 9, 7, 3, 1, 9, 7, 3)%10)%10 == digit(s, 7)
+}
+
+func isVATIE(s string) bool {
+	if reVATIEOld.MatchString(s) {
+		// convert the old format to the new one
+		s = "0" + s[2:7] + s[:1] + s[7:]
+	} else if !reVATIE.MatchString(s) {
+		return false
+	}
+	sum := weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)
+	if len(s) == 9 {
+		sum += 9 * strings.IndexByte("WABCDEFGHI", s[8])
+	}
+	return "WABCDEFGHIJKLMNOPQRSTUV"[sum%23] == s[7]
+}
+
+func isVATIT(s string) bool {
+	if !reDigits11.MatchString(s) {
+		return false
+	}
+	office, _ := strconv.Atoi(s[7:10])
+	if (office < 1 || office > 100) && office != 120 && office != 121 && office != 888 && office != 999 {
+		return false
+	}
+	return luhn(s)
+}
+
+func isVATLT(s string) bool {
+	if !reVATLT.MatchString(s) {
+		return false
+	}
+	n := len(s) - 1
+	sum := 0
+	for i := 0; i < n; i++ {
+		sum += digit(s, i) * (1 + i%9)
+	}
+	r := sum % 11
+	if r == 10 {
+		sum = 0
+		for i := 0; i < n; i++ {
+			sum += digit(s, i) * (1 + (i+2)%9)
+		}
+		r = sum % 11 % 10
+	}
+	return r == digit(s, n)
+}
+
+func isVATLU(s string) bool {
+	if !reDigits8.MatchString(s) {
+		return false
+	}
+	n, _ := strconv.Atoi(s[:6])
+	check, _ := strconv.Atoi(s[6:])
+	return n%89 == check
+}
+
+func isVATLV(s string) bool {
+	if !reDigits11.MatchString(s) {
+		return false
+	}
+	if s[0] <= '3' {
+		// a personal number
+		return true
+	}
+	r := 3 - weightedSum(s[:10], 9, 1, 4, 8, 3, 10, 2, 5, 7, 6)%11
+	if r < -1 {
+		r += 11
+	}
+	return r == digit(s, 10)
+}
+
+func isVATMT(s string) bool {
+	if !reDigits8.MatchString(s) || s[0] == '0' {
+		return false
+	}
+	check, _ := strconv.Atoi(s[6:])
+	return 37-weightedSum(s[:6], 3, 4, 6, 7, 8, 9)%37 == check
+}
+
+func isVATNL(s string) bool {
+	if !reVATNL.MatchString(s) {
+		return false
+	}
+	// either the former check of the fiscal number or the check of the VAT identification number of sole proprietors
+	return weightedSum(s[:8], 9, 8, 7, 6, 5, 4, 3, 2)%11 == digit(s, 8) || mod97("NL"+s) == 1
+}
+
+func isVATPL(s string) bool {
+	return reDigits10.MatchString(s) && weightedSum(s[:9], 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == digit(s, 9)
+}
+
+func isVATPT(s string) bool {
+	if !reDigits9.MatchString(s) || s[0] == '0' {
+		return false
+	}
+	r := 11 - weightedSum(s[:8], 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if r >= 10 {
+		r = 0
+	}
+	return r == digit(s, 8)
+}
+
+func isVATRO(s string) bool {
+	if !reVATRO.MatchString(s) {
+		return false
+	}
+	s = strings.Repeat("0", 10-len(s)) + s
+	return weightedSum(s[:9], 7, 5, 3, 2, 1, 7, 5, 3, 2)*10%11%10 == digit(s, 9)
+}
+
+func isVATSE(s string) bool {
+	return reVATSE.MatchString(s) && luhn(s[:10])
+}
+
+func isVATSI(s string) bool {
+	if !reDigits8.MatchString(s) || s[0] == '0' {
+		return false
+	}
+	r := 11 - weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)%11
+	return r != 11 && r%10 == digit(s, 7)
+}
+
+func isVATSK(s string) bool {
+	if !reVATSK.MatchString(s) {
+		return false
+	}
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n%11 == 0
+}
+
+func isVATGB(s string) bool {
+	if !reVATGB.MatchString(s) {
+		return false
+	}
+	if s[0] == 'G' || s[0] == 'H' {
+		// a government department or a health authority
+		return true
+	}
+	sum := weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)
+	check, _ := strconv.Atoi(s[7:9])
+	return (sum+check)%97 == 0 || (sum+check+55)%97 == 0
+}
+
+// isDNI checks if a string is a Spanish national identity number (DNI).
+func isDNI(s string) bool {
+	if !reDNI.MatchString(s) {
+		return false
+	}
+	n, _ := strconv.Atoi(s[:8])
+	return dniLetters[n%23] == s[8]
+}
+
+// isNIE checks if a string is a Spanish foreigner identity number (NIE).
+func isNIE(s string) bool {
+	return reNIE.MatchString(s) && isDNI(strconv.Itoa(strings.IndexByte("XYZ", s[0]))+s[1:])
+}
+
+// isCIF checks if a string is the tax identification number of a Spanish legal entity (formerly CIF).
+func isCIF(s string) bool {
+	if !reCIF.MatchString(s) {
+		return false
+	}
+	sum := 0
+	for i := 1; i <= 7; i++ {
+		d := digit(s, i)
+		if i%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	c := (10 - sum%10) % 10
+	letter, number := "JABCDEFGHI"[c] == s[8], c == digit(s, 8)
+	switch {
+	case strings.IndexByte("NPQRSW", s[0]) >= 0:
+		return letter
+	case strings.IndexByte("ABEH", s[0]) >= 0:
+		return number
+	}
+	return letter || number
+}
+
+// isNIFKLM checks if a string is a Spanish tax identification number of persons without DNI or NIE.
+func isNIFKLM(s string) bool {
+	if !reNIFKLM.MatchString(s) {
+		return false
+	}
+	n, _ := strconv.Atoi(s[1:8])
+	return dniLetters[n%23] == s[8]
+}
+
+// isABN checks if a string is an Australian Business Number.
+func isABN(s string) bool {
+	if !reDigits11.MatchString(s) || s[0] == '0' {
+		return false
+	}
+	weights := [...]int{10, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
+	sum := 0
+	for i := range weights {
+		d := digit(s, i)
+		if i == 0 {
+			d--
+		}
+		sum += d * weights[i]
+	}
+	return sum%89 == 0
+}
+
+// isGSTIN checks if a string is an Indian Goods and Services Tax Identification Number.
+func isGSTIN(s string) bool {
+	if !reGSTIN.MatchString(s) {
+		return false
+	}
+	if state, _ := strconv.Atoi(s[:2]); (state < 1 || state > 38) && state != 97 && state != 99 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 14; i++ {
+		v := alnumValue(rune(s[i])) * (1 + i%2)
+		sum += v/36 + v%36
+	}
+	return alnumChar((36-sum%36)%36) == s[14]
+}
+
+// isCPF checks if a string is a Brazilian individual taxpayer number (CPF).
+func isCPF(s string) bool {
+	if !reDigits11.MatchString(s) || strings.Count(s, s[:1]) == len(s) {
+		return false
+	}
+	return brazilianCheckDigits(s, []int{10, 9, 8, 7, 6, 5, 4, 3, 2}, []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2})
+}
+
+// isCNPJ checks if a string is a Brazilian company taxpayer number (CNPJ), either numeric or alphanumeric.
+func isCNPJ(s string) bool {
+	if !reCNPJ.MatchString(s) || strings.Count(s, s[:1]) == len(s) {
+		return false
+	}
+	return brazilianCheckDigits(s, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})
+}
+
+// brazilianCheckDigits checks the two modulo 11 check digits of a CPF or CNPJ.
+// The characters are valued by their ASCII code minus 48, so that digits have their usual values.
+func brazilianCheckDigits(s string, weights1, weights2 []int) bool {
+	for _, weights := range [][]int{weights1, weights2} {
+		sum := 0
+		for i, w := range weights {
+			sum += int(s[i]-'0') * w
+		}
+		r := sum % 11
+		if r < 2 {
+			r = 0
+		} else {
+			r = 11 - r
+		}
+		if r != digit(s, len(weights)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isRFC checks if a string is a Mexican taxpayer registry code (RFC) of a company or an individual.
+func isRFC(s string) bool {
+	if !reRFC.MatchString(s) {
+		return false
+	}
+	runes := []rune(s)
+	date := string(runes[len(runes)-9 : len(runes)-3])
+	if _, err := time.Parse("060102", date); err != nil {
+		return false
+	}
+	if len(runes) == 12 {
+		runes = append([]rune{' '}, runes...)
+	}
+	sum := 0
+	for i, c := range runes[:12] {
+		v := 0
+		for j, a := range rfcAlphabet {
+			if a == c {
+				v = j
+				break
+			}
+		}
+		sum += v * (13 - i)
+	}
+	check := byte('0')
+	switch r := 11 - sum%11; r {
+	case 11:
+	case 10:
+		check = 'A'
+	default:
+		check = byte('0' + r)
+	}
+	return byte(runes[12]) == check
+}
+
+// mod11_10 checks the last digit of a string of digits with the ISO 7064 MOD 11,10 algorithm.
+func mod11_10(s string) bool {
+	p := 10
+	for i := 0; i < len(s)-1; i++ {
+		r := (digit(s, i) + p) % 10
+		if r == 0 {
+			r = 10
+		}
+		p = r * 2 % 11
+	}
+	return (11-p)%10 == digit(s, len(s)-1)
+}
+
+// isBulgarianBirthDate checks if the first six digits of a Bulgarian personal number are a date of birth,
+// whose month is increased by 20 for the 19th century and by 40 for the 21st century.
+func isBulgarianBirthDate(s string) bool {
+	year, month := 1900+digit(s, 0)*10+digit(s, 1), digit(s, 2)*10+digit(s, 3)
+	switch {
+	case month > 40:
+		year, month = year+100, month-40
+	case month > 20:
+		year, month = year-100, month-20
+	}
+	return isDate(year, month, digit(s, 4)*10+digit(s, 5))
+}
+
+// isDate checks if the given year, month and day form a valid date.
+func isDate(year, month, day int) bool {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return t.Year() == year && int(t.Month()) == month && t.Day() == day
+}
+
+// weightedSum returns the sum of the digits of s multiplied by the corresponding weights.
+func weightedSum(s string, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += digit(s, i) * w
+	}
+	return sum
+}
+
+// digit returns the value of the digit at the given position of s.
+func digit(s string, i int) int {
+	return int(s[i] - '0')
+}
+
+// alnumChar returns the digit or upper case letter of the given value (see alnumValue).
+func alnumChar(v int) byte {
+	if v >= 10 {
+		return byte('A' + v - 10)
+	}
+	return byte('0' + v)
+}
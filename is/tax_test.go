@@ -0,0 +1,125 @@
+package is_test
+
+import (
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/prodadidb/go-validation/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVATNumber(t *testing.T) {
+	tests := []struct {
+		country string
+		valid   []string
+		invalid []string
+	}{
+		{"AT", []string{"ATU13585627", "U13585627"}, []string{"ATU13585626", "AT13585627"}},
+		{"BE", []string{"BE0403019261", "BE403019261", "BE 0403.019.261"}, []string{"BE0403019262", "BE2403019261"}},
+		{"BG", []string{"BG175074752", "BG7523169263", "BG8032056031"}, []string{"BG175074753", "BG7552A10004"}},
+		{"CY", []string{"CY10259033P"}, []string{"CY10259033Q", "CY12000000C"}},
+		{"CZ", []string{"CZ25123891", "CZ7103192745", "CZ640903926"}, []string{"CZ25123890", "CZ7103192746"}},
+		{"DE", []string{"DE136695976", "de 136 695 976"}, []string{"DE136695978", "DE036695976"}},
+		{"DK", []string{"DK13585628"}, []string{"DK13585627"}},
+		{"EE", []string{"EE100931558", "EE100594102"}, []string{"EE100931559", "EE200931558"}},
+		{"ES", []string{"ESA13585625", "ES54362315K", "ESX2482300W", "ESP0800000B", "ESM1234567L"}, []string{"ESA13585626", "ES54362315Z", "ESP08000002"}},
+		{"FI", []string{"FI20774740"}, []string{"FI20774741"}},
+		{"FR", []string{"FR40303265045", "FRK7399859412"}, []string{"FR41303265045", "FRI7399859412"}},
+		{"GR", []string{"EL094259216", "094259216"}, []string{"EL094259217", "GR094259216"}},
+		{"HR", []string{"HR33392005961"}, []string{"HR33392005962"}},
+		{"HU", []string{"HU12892312"}, []string{"HU12892313"}},
+		{"IE", []string{"IE6433435F", "IE6433435OA", "IE8D79739I"}, []string{"IE6433435E", "IE8D79739J"}},
+		{"IT", []string{"IT00743110157"}, []string{"IT00743110158", "IT00743150154"}},
+		{"LT", []string{"LT119511515", "LT100001919017"}, []string{"LT119511516", "LT119511525"}},
+		{"LU", []string{"LU15027442"}, []string{"LU15027443"}},
+		{"LV", []string{"LV40003521600", "LV16117519997"}, []string{"LV40003521601"}},
+		{"MT", []string{"MT11679112"}, []string{"MT11679113"}},
+		{"NL", []string{"NL004495445B01", "NL000099998B57"}, []string{"NL004495446B01", "NL004495445C01"}},
+		{"PL", []string{"PL8567346215"}, []string{"PL8567346216"}},
+		{"PT", []string{"PT501964843"}, []string{"PT501964842"}},
+		{"RO", []string{"RO18547290", "RO 185 472 90"}, []string{"RO18547291"}},
+		{"SE", []string{"SE123456789701"}, []string{"SE123456789801", "SE123456789702"}},
+		{"SI", []string{"SI50223054"}, []string{"SI50223055"}},
+		{"SK", []string{"SK2022749619"}, []string{"SK2022749618", "SK2012749610"}},
+		{"GB", []string{"GB980780684", "GB 980 7806 84", "GB242338087388", "GBGD100", "GBHA600"}, []string{"GB802311781", "GBGD600", "GBHA100"}},
+		{"AU", []string{"51824753556", "51 824 753 556"}, []string{"51824753557"}},
+		{"IN", []string{"27AAPFU0939F1ZV", "29AAGCB7383J1Z4"}, []string{"27AAPFU0939F1ZW", "40AAPFU0939F1ZV"}},
+	}
+	for _, test := range tests {
+		rule := is.VATNumber(test.country)
+		assert.Nil(t, rule.Validate(""), test.country)
+		for _, value := range test.valid {
+			assert.Nil(t, rule.Validate(value), test.country+" "+value)
+		}
+		for _, value := range test.invalid {
+			err := rule.Validate(value)
+			if assert.NotNil(t, err, test.country+" "+value) {
+				assert.Equal(t, "validation_is_vat_number", err.(validation.Error).Code(), test.country+" "+value)
+			}
+		}
+	}
+
+	err := is.VATNumber("US").Validate("123456789")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_tax_country", err.(validation.Error).Code())
+		assert.Equal(t, "US", err.(validation.Error).Params()["country"])
+	}
+	err = is.VATNumber("EL").Validate("094259216")
+	assert.Equal(t, "validation_is_tax_country", err.(validation.Error).Code())
+	assert.Nil(t, is.VATNumber("de").Validate("DE136695976"))
+	assert.EqualError(t, is.VATNumber("DE").Error("invalid").Validate("DE136695978"), "invalid")
+	assert.Equal(t, validation.NewError("code", "abc"), is.VATNumber("DE").ErrorObject(validation.NewError("code", "abc")).Validate("DE136695978"))
+}
+
+func TestTaxID(t *testing.T) {
+	tests := []struct {
+		country string
+		valid   []string
+		invalid []string
+	}{
+		{"BR", []string{"529.982.247-25", "52998224725", "11.222.333/0001-81", "12.ABC.345/01DE-35"}, []string{"529.982.247-26", "111.111.111-11", "11.222.333/0001-82", "00000000000000"}},
+		{"MX", []string{"GODE561231GR8", "SAT970701NN3", "gode-561231-gr8"}, []string{"GODE561231GR9", "GODE561331GR8", "SAT970701NN"}},
+		{"IN", []string{"27AAPFU0939F1ZV"}, []string{"27AAPFU0939F1Z"}},
+		{"AU", []string{"51824753556"}, []string{"01824753556"}},
+	}
+	for _, test := range tests {
+		rule := is.TaxID(test.country)
+		for _, value := range test.valid {
+			assert.Nil(t, rule.Validate(value), test.country+" "+value)
+		}
+		for _, value := range test.invalid {
+			err := rule.Validate(value)
+			if assert.NotNil(t, err, test.country+" "+value) {
+				assert.Equal(t, "validation_is_tax_id", err.(validation.Error).Code(), test.country+" "+value)
+			}
+		}
+	}
+	err := is.TaxID("DE").Validate("DE136695976")
+	assert.Equal(t, "validation_is_tax_country", err.(validation.Error).Code())
+}
+
+func TestTaxRuleCountryFrom(t *testing.T) {
+	type merchant struct {
+		Country string
+		VAT     string
+		TaxID   *string
+	}
+	taxID := "52998224725"
+	m := merchant{Country: "DE", VAT: "DE136695976", TaxID: &taxID}
+	rules := func(m *merchant) error {
+		return validation.ValidateStruct(m,
+			validation.Field(&m.VAT, is.VATNumber("").CountryFrom(&m.Country)),
+			validation.Field(&m.TaxID, is.TaxID("BR")),
+		)
+	}
+	assert.Nil(t, rules(&m))
+
+	m.Country = "AT"
+	assert.EqualError(t, rules(&m), "VAT: must be a valid VAT number.")
+	m.Country = ""
+	assert.EqualError(t, rules(&m), "VAT: cannot be validated for the given country.")
+
+	var country *string
+	err := is.VATNumber("DE").CountryFrom(country).Validate("DE136695976")
+	assert.Equal(t, "validation_is_tax_country", err.(validation.Error).Code())
+}
@@ -0,0 +1,222 @@
+package is
+
+import (
+	"errors"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/prodadidb/go-validation"
+)
+
+var (
+	// ErrURLScheme is the error that returns in case of a URL whose scheme is not allowed.
+	ErrURLScheme = validation.NewError("validation_is_url_scheme", "must use an allowed scheme")
+	// ErrURLUserInfo is the error that returns in case of a URL containing user information.
+	ErrURLUserInfo = validation.NewError("validation_is_url_user_info", "must not contain user information")
+	// ErrURLIPHost is the error that returns in case of a URL whose host is an IP address.
+	ErrURLIPHost = validation.NewError("validation_is_url_ip_host", "must not use an IP address as host")
+	// ErrURLPrivateHost is the error that returns in case of a URL whose host is a private or reserved address.
+	ErrURLPrivateHost = validation.NewError("validation_is_url_private_host", "must not point to a private or reserved address")
+	// ErrURLHost is the error that returns in case of a URL whose host is not allowed.
+	ErrURLHost = validation.NewError("validation_is_url_host", "must use an allowed host")
+	// ErrURLLength is the error that returns in case of a URL that is too long.
+	ErrURLLength = validation.NewError("validation_is_url_length", "must be no more than {{.max}} characters long")
+	// ErrURLFragment is the error that returns in case of a URL containing a fragment.
+	ErrURLFragment = validation.NewError("validation_is_url_fragment", "must not contain a fragment")
+)
+
+// URLOptions configures the checks performed by URLWith. The zero value only requires an absolute URL with a host.
+type URLOptions struct {
+	// Schemes lists the allowed schemes, such as "https". Any scheme is allowed if it is empty.
+	Schemes []string
+	// NoUserInfo disallows user information, such as "user:password@".
+	NoUserInfo bool
+	// NoIPHost disallows hosts that are IP addresses, including the legacy IPv4 forms like "0x7f.1" or "2130706433".
+	NoIPHost bool
+	// NoPrivateHost disallows hosts that are IP addresses outside the public ranges (see PublicIP), including
+	// the IPv6 addresses embedding non-public IPv4 addresses like "[64:ff9b::a9fe:a9fe]", as well as "localhost"
+	// and its subdomains. Domain names are not resolved.
+	NoPrivateHost bool
+	// HostSuffixes lists the allowed hosts. A host is allowed if it equals one of them or is a subdomain of it,
+	// e.g. "example.com" allows "example.com" and "api.example.com". Any host is allowed if it is empty.
+	HostSuffixes []string
+	// MaxLength is the maximum length of the URL in bytes. There is no limit if it is zero.
+	MaxLength int
+	// NoFragment disallows fragments, such as "#section".
+	NoFragment bool
+}
+
+// URLRule is a validation rule that checks a URL with the given options.
+type URLRule struct {
+	opts URLOptions
+	err  validation.Error
+}
+
+// URLWith returns a validation rule that checks if a string or url.URL is an absolute URL satisfying the given options.
+// Each failed check has its own error, e.g. ErrURLScheme or ErrURLPrivateHost, while a value that cannot be parsed
+// fails with ErrURL. For example, the following rule checks webhook URLs:
+//
+//	is.URLWith(is.URLOptions{
+//	    Schemes:       []string{"https"},
+//	    NoUserInfo:    true,
+//	    NoIPHost:      true,
+//	    NoPrivateHost: true,
+//	    HostSuffixes:  []string{"hooks.example.com"},
+//	    MaxLength:     2048,
+//	    NoFragment:    true,
+//	})
+//
+// As host names are not resolved, the rule cannot protect against host names pointing to private addresses.
+func URLWith(opts URLOptions) URLRule {
+	return URLRule{opts: opts}
+}
+
+// Error sets the error message used for all failed checks. The error codes are kept.
+func (r URLRule) Error(message string) URLRule {
+	if r.err == nil {
+		r.err = validation.NewError("", message)
+	} else {
+		r.err = r.err.SetMessage(message)
+	}
+	return r
+}
+
+// ErrorObject sets the error struct used for all failed checks.
+func (r URLRule) ErrorObject(err validation.Error) URLRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r URLRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	var u *url.URL
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		v, ok := value.(url.URL)
+		if !ok {
+			return errors.New("must be either a string, a byte slice or url.URL")
+		}
+		u, str = &v, v.String()
+	} else if u, err = url.Parse(str); err != nil {
+		return r.fail(ErrURL)
+	}
+
+	if err := r.check(u, str); err != nil {
+		return r.fail(err)
+	}
+	return nil
+}
+
+// check returns the error of the first check the parsed URL u fails. str is the URL as a string.
+func (r URLRule) check(u *url.URL, str string) validation.Error {
+	if r.opts.MaxLength > 0 && len(str) > r.opts.MaxLength {
+		return ErrURLLength.SetParams(map[string]interface{}{"max": r.opts.MaxLength})
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if u.Scheme == "" || host == "" {
+		return ErrURL
+	}
+	if len(r.opts.Schemes) > 0 && !containsFold(r.opts.Schemes, u.Scheme) {
+		return ErrURLScheme.SetParams(map[string]interface{}{"schemes": r.opts.Schemes})
+	}
+	if r.opts.NoUserInfo && u.User != nil {
+		return ErrURLUserInfo
+	}
+
+	ip, isIP := parseHostIP(host)
+	if r.opts.NoIPHost && isIP {
+		return ErrURLIPHost
+	}
+	if r.opts.NoPrivateHost {
+		if isIP && PublicIP.Validate(ip) != nil || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+			return ErrURLPrivateHost
+		}
+	}
+	if len(r.opts.HostSuffixes) > 0 && !hasHostSuffix(host, r.opts.HostSuffixes) {
+		return ErrURLHost.SetParams(map[string]interface{}{"hosts": r.opts.HostSuffixes})
+	}
+
+	if r.opts.NoFragment && (u.Fragment != "" || strings.Contains(str, "#")) {
+		return ErrURLFragment
+	}
+	return nil
+}
+
+// fail returns err customized by Error or ErrorObject.
+func (r URLRule) fail(err validation.Error) validation.Error {
+	if r.err == nil {
+		return err
+	}
+	if r.err.Code() == "" {
+		return err.SetMessage(r.err.Message())
+	}
+	return r.err
+}
+
+// parseHostIP parses a host that is an IP address. Besides the standard notations,
+// it recognizes the legacy IPv4 forms accepted by many resolvers, such as "0x7f.1" or "2130706433".
+func parseHostIP(host string) (netip.Addr, bool) {
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return ip, true
+	}
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		base := 10
+		if len(part) > 2 && (part[:2] == "0x" || part[:2] == "0X") {
+			part, base = part[2:], 16
+		} else if len(part) > 1 && part[0] == '0' {
+			part, base = part[1:], 8
+		}
+		v, err := strconv.ParseUint(part, base, 32)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		values[i] = v
+	}
+	// the last part fills the remaining bytes of the address
+	var ip uint64
+	for i, v := range values {
+		if i < len(values)-1 {
+			if v > 0xff {
+				return netip.Addr{}, false
+			}
+			ip |= v << (8 * (3 - i))
+		} else {
+			if v >= 1<<(8*(4-i)) {
+				return netip.Addr{}, false
+			}
+			ip |= v
+		}
+	}
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+func hasHostSuffix(host string, suffixes []string) bool {
+	for _, s := range suffixes {
+		s = strings.TrimPrefix(strings.ToLower(s), ".")
+		if host == s || strings.HasSuffix(host, "."+s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package utils
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Punycode parameters (RFC 3492, section 5)
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+	acePrefix       = "xn--"
+)
+
+// labelSeparators replaces the full stops that IDNA treats as label separators with ".".
+var labelSeparators = strings.NewReplacer("。", ".", "．", ".", "｡", ".")
+
+// ToASCII converts an internationalized domain name to its ASCII form by lowercasing it and converting
+// its Unicode labels (U-labels) to punycode labels prefixed with "xn--" (A-labels), e.g. "Bücher.de" to
+// "xn--bcher-kva.de". It returns false if a label cannot be converted, or if a label starting with "xn--"
+// is not the valid A-label of a Unicode label.
+// The ASCII labels are returned as they are, so the result must still be checked as a domain name.
+// The conversion follows IDNA 2008 in a simplified way: the name is not normalized, and a label may only
+// contain letters, marks, digits and hyphens, must not start with a mark, and must not start or end with a hyphen.
+func ToASCII(domain string) (string, bool) {
+	return convertLabels(domain, func(label string) (string, bool) {
+		if IsASCII(label) {
+			if strings.HasPrefix(label, acePrefix) {
+				if _, ok := decodeALabel(label); !ok {
+					return "", false
+				}
+			}
+			return label, true
+		}
+		if !isULabel(label) {
+			return "", false
+		}
+		encoded, ok := punyEncode(label)
+		return acePrefix + encoded, ok
+	})
+}
+
+// ToUnicode converts the A-labels of a domain name to Unicode labels, e.g. "xn--bcher-kva.de" to "bücher.de".
+// It returns false if a label starting with "xn--" is not the valid A-label of a Unicode label.
+func ToUnicode(domain string) (string, bool) {
+	return convertLabels(domain, func(label string) (string, bool) {
+		if strings.HasPrefix(label, acePrefix) {
+			return decodeALabel(label)
+		}
+		return label, true
+	})
+}
+
+// convertLabels lowercases domain and converts each of its labels with convert.
+// A trailing dot denoting the root is kept, while other empty labels are invalid.
+func convertLabels(domain string, convert func(string) (string, bool)) (string, bool) {
+	labels := strings.Split(strings.ToLower(labelSeparators.Replace(domain)), ".")
+	for i, label := range labels {
+		if label == "" {
+			if i > 0 && i == len(labels)-1 {
+				break
+			}
+			return "", false
+		}
+		var ok bool
+		if labels[i], ok = convert(label); !ok {
+			return "", false
+		}
+	}
+	return strings.Join(labels, "."), true
+}
+
+// decodeALabel decodes an A-label to a Unicode label. The label is valid only if the Unicode label
+// is lowercase, contains non-ASCII characters, satisfies isULabel and encodes back to the same A-label.
+func decodeALabel(label string) (string, bool) {
+	decoded, ok := punyDecode(label[len(acePrefix):])
+	if !ok || IsASCII(decoded) || strings.ToLower(decoded) != decoded || !isULabel(decoded) {
+		return "", false
+	}
+	if encoded, ok := punyEncode(decoded); !ok || acePrefix+encoded != label {
+		return "", false
+	}
+	return decoded, true
+}
+
+// isULabel checks if a label only contains letters, marks, digits and hyphens, does not start with a mark,
+// does not start or end with a hyphen and does not have hyphens in the third and fourth positions.
+func isULabel(label string) bool {
+	if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	if runes := []rune(label); len(runes) >= 4 && runes[2] == '-' && runes[3] == '-' {
+		return false
+	}
+	for i, r := range label {
+		switch {
+		case r == '-' || r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+		case r < utf8.RuneSelf:
+			return false
+		case unicode.IsMark(r):
+			if i == 0 {
+				return false
+			}
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			return false
+		}
+	}
+	return true
+}
+
+// punyEncode encodes a string with punycode (RFC 3492, section 6.3).
+func punyEncode(s string) (string, bool) {
+	runes := []rune(s)
+	out := make([]byte, 0, len(s))
+	for _, r := range runes {
+		if r < punyInitialN {
+			out = append(out, byte(r))
+		}
+	}
+	b := len(out)
+	h := b
+	if b > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := rune(punyInitialN), 0, punyInitialBias
+	for h < len(runes) {
+		m := rune(math.MaxInt32)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		if int(m-n) > (math.MaxInt32-delta)/(h+1) {
+			return "", false
+		}
+		delta += int(m-n) * (h + 1)
+		n = m
+		for _, r := range runes {
+			if r < n {
+				if delta++; delta == math.MaxInt32 {
+					return "", false
+				}
+			}
+			if r != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return string(out), true
+}
+
+// punyDecode decodes a punycode string (RFC 3492, section 6.2).
+func punyDecode(s string) (string, bool) {
+	var out []rune
+	pos := 0
+	if d := strings.LastIndexByte(s, '-'); d >= 0 {
+		for i := 0; i < d; i++ {
+			if s[i] >= utf8.RuneSelf {
+				return "", false
+			}
+			out = append(out, rune(s[i]))
+		}
+		pos = d + 1
+	}
+
+	n, i, bias := punyInitialN, 0, punyInitialBias
+	for pos < len(s) {
+		oldi, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if pos == len(s) {
+				return "", false
+			}
+			digit, ok := punyDigitValue(s[pos])
+			pos++
+			if !ok || digit > (math.MaxInt32-i)/w {
+				return "", false
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > math.MaxInt32/(punyBase-t) {
+				return "", false
+			}
+			w *= punyBase - t
+		}
+		count := len(out) + 1
+		bias = punyAdapt(i-oldi, count, oldi == 0)
+		if i/count > math.MaxInt32-n {
+			return "", false
+		}
+		n += i / count
+		i %= count
+		if n < punyInitialN || n > unicode.MaxRune || n >= 0xd800 && n <= 0xdfff {
+			return "", false
+		}
+		out = append(out, 0)
+		copy(out[i+1:], out[i:])
+		out[i] = rune(n)
+		i++
+	}
+	return string(out), true
+}
+
+// punyAdapt is the bias adaptation function (RFC 3492, section 6.1).
+func punyAdapt(delta, count int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / count
+	k := 0
+	for delta > (punyBase-punyTMin)*punyTMax/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyThreshold(k, bias int) int {
+	if k <= bias {
+		return punyTMin
+	}
+	if k >= bias+punyTMax {
+		return punyTMax
+	}
+	return k - bias
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyDigitValue(c byte) (int, bool) {
+	switch {
+	case c >= 'a' && c <= 'z':
+		return int(c - 'a'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c - 'A'), true
+	case c >= '0' && c <= '9':
+		return int(c-'0') + 26, true
+	}
+	return 0, false
+}
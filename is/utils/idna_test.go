@@ -0,0 +1,78 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/prodadidb/go-validation/is/utils"
+)
+
+func TestToASCII(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+		ok       bool
+	}{
+		{"example.com", "example.com", true},
+		{"Example.COM.", "example.com.", true},
+		{"bücher.de", "xn--bcher-kva.de", true},
+		{"MÜNCHEN.de", "xn--mnchen-3ya.de", true},
+		{"例子。测试", "xn--fsqu00a.xn--0zwm56d", true},
+		{"пример.рф", "xn--e1afmkfd.xn--p1ai", true},
+		{"παράδειγμα.gr", "xn--hxajbheg2az3al.gr", true},
+		{"ドメイン名例.jp", "xn--eckwd4c7cu47r2wf.jp", true},
+		{"3年B組金八先生.jp", "xn--3b-ww4c5e180e575a65lsy2b.jp", true},
+		{"xn--bcher-kva.de", "xn--bcher-kva.de", true},
+		{"XN--BCHER-KVA.de", "xn--bcher-kva.de", true},
+		{"localhost", "localhost", true},
+		{"", "", false},
+		{"a..b", "", false},
+		{".a", "", false},
+		{"bü cher.de", "", false},
+		{"bücher!.de", "", false},
+		{"-bücher.de", "", false},
+		{"bücher-.de", "", false},
+		{"bü--cher.de", "", false},
+		{"́bcher.de", "", false},
+		{"☃.com", "", false},
+		{"xn--bcher.de", "", false},
+		{"xn--abc.de", "", false},
+		{"xn--bcher-kva-.de", "", false},
+		{"xn--tda", "xn--tda", true},
+		{"xn--Tda", "xn--tda", true},
+		{"xn--bcher-k_a.de", "", false},
+		{"xn--.de", "", false},
+	}
+	for _, test := range tests {
+		actual, ok := utils.ToASCII(test.param)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("Expected ToASCII(%q) to be (%q, %v), got (%q, %v)", test.param, test.expected, test.ok, actual, ok)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+		ok       bool
+	}{
+		{"example.com", "example.com", true},
+		{"xn--bcher-kva.de", "bücher.de", true},
+		{"XN--MNCHEN-3YA.de.", "münchen.de.", true},
+		{"xn--fsqu00a.xn--0zwm56d", "例子.测试", true},
+		{"xn--3b-ww4c5e180e575a65lsy2b", "3年b組金八先生", true},
+		{"xn--abc.de", "", false},
+		{"xn--bcher-.de", "", false},
+		{"a..b", "", false},
+	}
+	for _, test := range tests {
+		actual, ok := utils.ToUnicode(test.param)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("Expected ToUnicode(%q) to be (%q, %v), got (%q, %v)", test.param, test.expected, test.ok, actual, ok)
+		}
+	}
+}
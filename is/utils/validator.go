@@ -71,7 +71,6 @@ func SetNilPtrAllowedByRequired(value bool) {
 
 // IsEmail check if the string is an email.
 func IsEmail(str string) bool {
-	// TODO uppercase letters are not supported
 	return rxEmail.MatchString(str)
 }
 
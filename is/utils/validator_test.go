@@ -690,6 +690,11 @@ func TestIsEmail(t *testing.T) {
 		{"hans.m端ller@test.com", true},
 		{"NathAn.daVIeS@DomaIn.cOM", true},
 		{"NATHAN.DAVIES@DOMAIN.CO.UK", true},
+		{"John.Doe@Example.COM", true},
+		{"Foo+Bar@Bar.Coffee", true},
+		{"hans@M端LLER.COM", true},
+		{"John.Doe@Example..COM", false},
+		{"John.Doe@", false},
 	}
 	for _, test := range tests {
 		actual := utils.IsEmail(test.param)
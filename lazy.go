@@ -0,0 +1,68 @@
+package validation
+
+import "context"
+
+// DefaultLazyMaxDepth is the maximum number of nested Lazy rules allowed by default.
+const DefaultLazyMaxDepth = 64
+
+// ErrMaxDepth is the error that returns when a value is nested deeper than allowed by Lazy rules.
+var ErrMaxDepth = NewError("validation_max_depth", "must not be nested deeper than {{.max}} levels")
+
+type (
+	// LazyRule is a validation rule that obtains the rules to apply when the validation is performed.
+	LazyRule struct {
+		f        func() Rule
+		maxDepth int
+	}
+
+	lazyDepthKey struct{}
+)
+
+// Lazy returns a validation rule that calls f to obtain the rule to validate a value with.
+// Because f is only called when the validation is performed, a rule may refer to itself,
+// which allows validating recursive data such as trees decoded into maps. For example,
+//
+//	var menu validation.Rule
+//	menu = validation.Map(
+//	    validation.Key("label", validation.Required),
+//	    validation.Key("items", validation.Each(validation.Lazy(func() validation.Rule { return menu }))).Optional(),
+//	)
+//
+// To protect against deeply nested input, Lazy rules can only be nested up to DefaultLazyMaxDepth levels;
+// a value nested deeper fails with ErrMaxDepth. Use MaxDepth to change the limit.
+//
+// This limit is separate from the one set by WithMaxDepth. It only counts the nested Lazy rules, and exceeding it
+// is a validation error of the value, as the input is invalid. The limit set by WithMaxDepth (DefaultMaxDepth
+// by default) counts the nested values ValidateWithContext descends into because they are validatable or hold
+// validatable elements, and exceeding it is an internal error wrapping ErrDepthExceeded, as ErrCycle is.
+func Lazy(f func() Rule) LazyRule {
+	return LazyRule{f: f, maxDepth: DefaultLazyMaxDepth}
+}
+
+// MaxDepth sets the maximum number of nested Lazy rules, including this one. A depth that is not positive
+// removes the limit.
+func (r LazyRule) MaxDepth(depth int) LazyRule {
+	r.maxDepth = depth
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r LazyRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.TODO(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+func (r LazyRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	depth, _ := ctx.Value(lazyDepthKey{}).(int)
+	if r.maxDepth > 0 && depth >= r.maxDepth {
+		return ErrMaxDepth.SetParams(map[string]interface{}{"max": r.maxDepth})
+	}
+	rule := r.f()
+	if rule == nil {
+		return nil
+	}
+	return ValidateWithContext(context.WithValue(ctx, lazyDepthKey{}, depth+1), value, rule)
+}
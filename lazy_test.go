@@ -0,0 +1,81 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	var menu validation.Rule
+	menu = validation.Map(
+		validation.Key("label", validation.Required),
+		validation.Key("items", validation.Each(validation.Lazy(func() validation.Rule { return menu }))).Optional(),
+	)
+	decode := func(s string) map[string]interface{} {
+		var m map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(s), &m))
+		return m
+	}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", decode(`{"label": "root"}`), ""},
+		{"t2", decode(`{"label": "root", "items": [{"label": "a"}, {"label": "b", "items": [{"label": "c"}]}]}`), ""},
+		{"t3", decode(`{"label": "root", "items": [{"label": ""}, {"label": "b", "items": [{"label": "c", "extra": 1}]}]}`), "items: (0: (label: cannot be blank.); 1: (items: (0: (extra: key not expected.).).).)."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, menu)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateWithContext(context.Background(), test.value, menu)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// nil rule
+	assert.NoError(t, validation.Validate("abc", validation.Lazy(func() validation.Rule { return nil })))
+}
+
+func TestLazyMaxDepth(t *testing.T) {
+	var node validation.Rule
+	node = validation.Each(validation.Lazy(func() validation.Rule { return node }).MaxDepth(3))
+	nest := func(depth int) interface{} {
+		var v interface{} = []interface{}{}
+		for i := 0; i < depth; i++ {
+			v = []interface{}{v}
+		}
+		return v
+	}
+
+	assert.NoError(t, validation.Validate(nest(3), node))
+	err := validation.Validate(nest(4), node)
+	assert.EqualError(t, err, "0: (0: (0: (0: must not be nested deeper than 3 levels.).).).")
+
+	// the default limit protects against hostile input
+	var deep validation.Rule
+	deep = validation.Each(validation.Lazy(func() validation.Rule { return deep }))
+	err = validation.Validate(nest(100000), deep)
+	if assert.Error(t, err) {
+		assert.True(t, strings.Contains(err.Error(), "must not be nested deeper than 64 levels"))
+	}
+	var e validation.Error
+	for es, ok := err.(validation.Errors); ok; es, ok = err.(validation.Errors) {
+		err = es["0"]
+	}
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, "validation_max_depth", e.Code())
+	}
+
+	// a depth that is not positive removes the limit
+	var unlimited validation.Rule
+	unlimited = validation.Each(validation.Lazy(func() validation.Rule { return unlimited }).MaxDepth(0))
+	assert.NoError(t, validation.Validate(nest(100), unlimited))
+	unlimited = validation.Each(validation.Lazy(func() validation.Rule { return unlimited }).MaxDepth(-1))
+	assert.NoError(t, validation.Validate(nest(100), unlimited))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 )
 
 var (
@@ -25,6 +26,7 @@ type (
 	// MapRule represents a rule set associated with a map.
 	MapRule struct {
 		keys           []*KeyRules
+		groups         []keyGroup
 		allowExtraKeys bool
 	}
 
@@ -33,6 +35,11 @@ type (
 		key      interface{}
 		optional bool
 		rules    []Rule
+		// pattern is set for the keys created by KeyPattern.
+		pattern *regexp.Regexp
+		// other is set for the keys created by OtherKeys.
+		other     bool
+		nameRules []Rule
 	}
 )
 
@@ -47,6 +54,8 @@ type (
 //	    validation.Key("Value", validation.Required, validation.Length(5, 10)),
 //	)
 //
+// Use KeyPattern() and OtherKeys() to validate the keys that are not known in advance.
+//
 // A nil value is considered valid. Use the Required rule to make sure a map value is present.
 func Map(keys ...*KeyRules) MapRule {
 	return MapRule{keys: keys}
@@ -79,17 +88,26 @@ func (r MapRule) ValidateWithContext(ctx context.Context, m interface{}) error {
 	}
 
 	errs := Errors{}
+	c := newErrorCollector(ctx, errs)
 	kt := value.Type().Key()
 
-	var extraKeys map[interface{}]bool
-	if !r.allowExtraKeys {
-		extraKeys = make(map[interface{}]bool, value.Len())
+	var extraKeys map[interface{}]reflect.Value
+	if !r.allowExtraKeys || r.hasDynamicKeys() {
+		extraKeys = make(map[interface{}]reflect.Value, value.Len())
 		for _, k := range value.MapKeys() {
-			extraKeys[k.Interface()] = true
+			extraKeys[k.Interface()] = k
 		}
 	}
 
 	for _, kr := range r.keys {
+		if kr.pattern != nil || kr.other {
+			continue
+		}
+		delete(extraKeys, kr.key)
+		if c.stop(1) {
+			continue
+		}
+		mark := c.mark()
 		var err error
 		if kv := reflect.ValueOf(kr.key); !kt.AssignableTo(kv.Type()) {
 			err = ErrKeyWrongType
@@ -97,32 +115,41 @@ func (r MapRule) ValidateWithContext(ctx context.Context, m interface{}) error {
 			if !kr.optional {
 				err = ErrKeyMissing
 			}
-		} else if ctx == nil {
-			err = Validate(vv.Interface(), kr.rules...)
 		} else {
-			err = ValidateWithContext(ctx, vv.Interface(), kr.rules...)
+			err = kr.validate(ctx, kr.key, vv.Interface())
 		}
 		if err != nil {
 			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
 				return err
 			}
-			errs[getErrorKeyName(kr.key)] = err
-		}
-		if !r.allowExtraKeys {
-			delete(extraKeys, kr.key)
+			c.add(getErrorKeyName(kr.key), err, mark)
 		}
 	}
 
-	if !r.allowExtraKeys {
-		for key := range extraKeys {
-			errs[getErrorKeyName(key)] = ErrKeyUnexpected
+	for key, kv := range extraKeys {
+		name := getErrorKeyName(key)
+		kr := r.findDynamicKey(name)
+		if kr == nil && r.allowExtraKeys || c.stop(1) {
+			continue
+		}
+		mark := c.mark()
+		if kr == nil {
+			c.add(name, ErrKeyUnexpected, mark)
+			continue
+		}
+		if err := kr.validate(ctx, key, value.MapIndex(kv).Interface()); err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			c.add(name, err, mark)
 		}
 	}
 
-	if len(errs) > 0 {
-		return errs
+	for _, g := range r.groups {
+		g.validate(value, errs)
 	}
-	return nil
+
+	return c.result()
 }
 
 // Key specifies a map key and the corresponding validation rules.
@@ -133,12 +160,91 @@ func Key(key interface{}, rules ...Rule) *KeyRules {
 	}
 }
 
+// KeyPattern specifies the rules for the values of all keys whose names match the given pattern.
+// The name of a key is the one used to report its errors (see Key). Pattern keys only apply to the keys
+// that are not listed by Key(), and the first matching pattern wins. The matching keys are not reported
+// as unexpected. For example,
+//
+//	validation.Map(
+//	    validation.Key("name", validation.Required),
+//	    validation.KeyPattern(regexp.MustCompile("^x-"), validation.Length(0, 256)),
+//	)
+func KeyPattern(pattern *regexp.Regexp, rules ...Rule) *KeyRules {
+	return &KeyRules{
+		pattern: pattern,
+		rules:   rules,
+	}
+}
+
+// OtherKeys specifies the rules for the values of all keys that are neither listed by Key()
+// nor matched by KeyPattern(). These keys are not reported as unexpected. For example,
+//
+//	validation.Map(
+//	    validation.Key("name", validation.Required),
+//	    validation.OtherKeys(validation.Required).Name(is.LowerCase),
+//	)
+func OtherKeys(rules ...Rule) *KeyRules {
+	return &KeyRules{
+		other: true,
+		rules: rules,
+	}
+}
+
+// Name configures the rules that the key itself must satisfy.
+// The rules are checked before the rules of the value, and an error is reported under the name of the key.
+func (r *KeyRules) Name(rules ...Rule) *KeyRules {
+	r.nameRules = rules
+	return r
+}
+
 // Optional configures the rule to ignore the key if missing.
 func (r *KeyRules) Optional() *KeyRules {
 	r.optional = true
 	return r
 }
 
+// validate checks the key against the name rules and its value against the value rules.
+func (r *KeyRules) validate(ctx context.Context, key, value interface{}) error {
+	if ctx == nil {
+		if len(r.nameRules) > 0 {
+			if err := Validate(key, r.nameRules...); err != nil {
+				return err
+			}
+		}
+		return Validate(value, r.rules...)
+	}
+	if len(r.nameRules) > 0 {
+		if err := ValidateWithContext(ctx, key, r.nameRules...); err != nil {
+			return err
+		}
+	}
+	return ValidateWithContext(ctx, value, r.rules...)
+}
+
+// hasDynamicKeys checks if the rule has pattern keys or other keys.
+func (r MapRule) hasDynamicKeys() bool {
+	for _, kr := range r.keys {
+		if kr.pattern != nil || kr.other {
+			return true
+		}
+	}
+	return false
+}
+
+// findDynamicKey returns the first pattern key matching the given key name, or the other keys if none matches.
+func (r MapRule) findDynamicKey(name string) *KeyRules {
+	var other *KeyRules
+	for _, kr := range r.keys {
+		if kr.pattern != nil && kr.pattern.MatchString(name) {
+			return kr
+		}
+		if kr.other && other == nil {
+			other = kr
+		}
+	}
+	return other
+}
+
 // getErrorKeyName returns the name that should be used to represent the validation error of a map key.
 func getErrorKeyName(key interface{}) string {
 	return fmt.Sprintf("%v", key)
@@ -2,6 +2,7 @@ package validation_test
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/prodadidb/go-validation"
@@ -118,3 +119,46 @@ func TestMapWithContext(t *testing.T) {
 		assert.Equal(t, "Extra: key not expected; Value: the length must be between 5 and 10.", err.Error())
 	}
 }
+
+func TestMapDynamicKeys(t *testing.T) {
+	lower := validation.NewStringRule(func(s string) bool { return regexp.MustCompile("^[a-z0-9-]+$").MatchString(s) }, "must be a lowercase DNS label")
+	rule := validation.Map(
+		validation.Key("name", validation.Required),
+		validation.KeyPattern(regexp.MustCompile("^x-"), validation.Required, validation.Length(0, 5)),
+		validation.OtherKeys(validation.Required).Name(lower),
+	)
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]string{"name": "abc"}, ""},
+		{"t2", map[string]string{"name": "abc", "x-trace": "123", "app": "web"}, ""},
+		{"t3", map[string]string{"name": "abc", "x-trace": "123456", "x-empty": "", "app": ""}, "app: cannot be blank; x-empty: cannot be blank; x-trace: the length must be no more than 5."},
+		{"t4", map[string]string{"name": "", "App": "web", "x-Upper": "abc"}, "App: must be a lowercase DNS label; name: cannot be blank."},
+		{"t5", map[int]string{1: "a", 2: ""}, "1: must be either a string or byte slice; 2: must be either a string or byte slice; name: key not the correct type."},
+		{"t6", map[interface{}]interface{}{nil: "a", "Name": "b"}, "Name: must be a lowercase DNS label; name: key not the correct type."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateWithContext(context.Background(), test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// unmatched keys are still unexpected without OtherKeys
+	rule = validation.Map(validation.KeyPattern(regexp.MustCompile("^x-")))
+	err := validation.Validate(map[string]string{"x-a": "1", "b": "2"}, rule)
+	assert.EqualError(t, err, "b: key not expected.")
+	err = validation.Validate(map[string]string{"x-a": "1", "b": "2"}, rule.AllowExtraKeys())
+	assert.NoError(t, err)
+
+	// the first matching pattern wins
+	rule = validation.Map(
+		validation.KeyPattern(regexp.MustCompile("^x-a"), validation.Length(1, 1)),
+		validation.KeyPattern(regexp.MustCompile("^x-"), validation.Length(2, 2)),
+	)
+	err = validation.Validate(map[string]string{"x-a": "1", "x-b": "1"}, rule)
+	assert.EqualError(t, err, "x-b: the length must be exactly 2.")
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+)
+
+// nestedRule validates the struct referenced by structPtr using a set of field rules.
+type nestedRule struct {
+	structPtr interface{}
+	fields    []*FieldRules
+}
+
+// Nested specifies a struct field that should be validated using the given field rules,
+// without requiring the field type to implement Validatable.
+// The struct field must be specified as a pointer to it, and the field rules must refer to
+// the fields of the nested struct. The errors found in the nested struct are reported as
+// Errors under the name of the struct field. For example,
+//
+//	err := validation.ValidateStruct(&c,
+//	    validation.Field(&c.Name, validation.Required),
+//	    validation.Nested(&c.Address,
+//	        validation.Field(&c.Address.Street, validation.Required),
+//	        validation.Field(&c.Address.City, validation.Required),
+//	    ),
+//	)
+//	fmt.Println(err)
+//	// Address: (City: cannot be blank; Street: cannot be blank.).
+//
+// The field may also be a pointer to a struct. A nil pointer is considered valid;
+// call Required on the returned FieldRules to report it as an error instead.
+// Note that the rules for the fields of a nil struct pointer cannot be built,
+// so they should only be passed when the pointer is not nil:
+//
+//	var billing []*validation.FieldRules
+//	if c.Billing != nil {
+//	    billing = append(billing, validation.Field(&c.Billing.City, validation.Required))
+//	}
+//	err := validation.ValidateStruct(&c, validation.Nested(&c.Billing, billing...).Required())
+func Nested(structPtr interface{}, fields ...*FieldRules) *FieldRules {
+	return &FieldRules{
+		fieldPtr: structPtr,
+		rules:    []Rule{nestedRule{structPtr: structPtr, fields: fields}},
+	}
+}
+
+// Required configures the field to be validated by the Required rule before its other rules.
+// This is mainly useful for Nested fields holding a pointer to a struct that must not be nil.
+func (r *FieldRules) Required() *FieldRules {
+	r.rules = append([]Rule{Required}, r.rules...)
+	return r
+}
+
+// Validate checks if the nested struct is valid or not.
+func (r nestedRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.TODO(), value)
+}
+
+// ValidateWithContext checks if the nested struct is valid or not.
+func (r nestedRule) ValidateWithContext(ctx context.Context, _ interface{}) error {
+	value := reflect.ValueOf(r.structPtr)
+	if value.Kind() == reflect.Ptr && !value.IsNil() && value.Elem().Kind() == reflect.Ptr {
+		// the field holds a pointer to the nested struct
+		value = value.Elem()
+	}
+	return ValidateStructWithContext(ctx, value.Interface(), r.fields...)
+}
@@ -0,0 +1,102 @@
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type NestedAddress struct {
+	Street string
+	City   string `json:"city"`
+}
+
+type NestedCustomer struct {
+	Name     string
+	Address  NestedAddress `json:"address"`
+	Billing  *NestedAddress
+	Previous NestedAddress
+	Model3
+}
+
+func TestNested(t *testing.T) {
+	c1 := NestedCustomer{Name: "John", Address: NestedAddress{Street: "Main", City: "Town"}}
+	c2 := NestedCustomer{Billing: &NestedAddress{}}
+
+	tests := []struct {
+		tag   string
+		model *NestedCustomer
+		rules func(c *NestedCustomer) []*validation.FieldRules
+		err   string
+	}{
+		{"t1.1", &c1, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&c.Name, validation.Required),
+				validation.Nested(&c.Address,
+					validation.Field(&c.Address.Street, validation.Required),
+					validation.Field(&c.Address.City, validation.Required),
+				),
+			}
+		}, ""},
+		{"t1.2", &c2, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&c.Name, validation.Required),
+				validation.Nested(&c.Address,
+					validation.Field(&c.Address.Street, validation.Required),
+					validation.Field(&c.Address.City, validation.Required),
+				),
+			}
+		}, "Name: cannot be blank; address: (Street: cannot be blank; city: cannot be blank.)."},
+		// pointer field
+		{"t2.1", &c1, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Nested(&c.Billing),
+			}
+		}, ""},
+		{"t2.2", &c1, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Nested(&c.Billing).Required(),
+			}
+		}, "Billing: cannot be blank."},
+		{"t2.3", &c2, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Nested(&c.Billing,
+					validation.Field(&c.Billing.Street, validation.Required),
+				).Required(),
+			}
+		}, "Billing: (Street: cannot be blank.)."},
+		// deeper nesting and embedded validatable
+		{"t3.1", &c2, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Nested(&c.Model3),
+				validation.Nested(&c.Previous,
+					validation.Field(&c.Previous.City, validation.Length(5, 10)),
+				),
+			}
+		}, "A: error abc."},
+		// field of another struct
+		{"t4.1", &c2, func(c *NestedCustomer) []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Nested(&c.Address,
+					validation.Field(&c.Previous.City, validation.Required),
+				),
+			}
+		}, "field #0 cannot be found in the struct"},
+	}
+
+	for _, test := range tests {
+		err := validation.ValidateStruct(test.model, test.rules(test.model)...)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateStructWithContext(context.Background(), test.model, test.rules(test.model)...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.ValidateStruct(&c2, validation.Nested(&c2.Address,
+		validation.Field(&c2.Address.City, validation.Required),
+	))
+	if assert.IsType(t, validation.Errors{}, err) {
+		assert.IsType(t, validation.Errors{}, err.(validation.Errors)["address"])
+	}
+}
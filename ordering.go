@@ -0,0 +1,265 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var (
+	// ErrSorted is the error that returns for an element of an iterable that is out of order.
+	ErrSorted = NewError("validation_sorted", "must be in {{.order}} order")
+	// ErrOverlapping is the error that returns for an element of an iterable whose range overlaps another one.
+	ErrOverlapping = NewError("validation_overlapping", "must not overlap with {{.overlaps}}")
+)
+
+type (
+	// SortedRule is a validation rule that checks if the elements of a slice or array are sorted.
+	SortedRule struct {
+		desc   bool
+		strict bool
+		key    func(value interface{}) interface{}
+		err    Error
+	}
+
+	// NonOverlappingRule is a validation rule that checks if the ranges represented by the elements
+	// of a slice or array do not overlap.
+	NonOverlappingRule struct {
+		start, end func(value interface{}) interface{}
+		inclusive  bool
+		err        Error
+	}
+)
+
+// Sorted returns a validation rule that checks if the elements of a slice or array are in ascending order.
+// Call Desc to check for descending order, Strict to disallow equal elements, and By to compare the keys
+// extracted from the elements instead of the elements themselves. Only int, uint, float, string and time.Time
+// values can be compared, and pointers are compared by the values they refer to. Nil elements cannot be compared.
+// An error is reported for each element that is out of order with respect to the previous element.
+func Sorted() SortedRule {
+	return SortedRule{err: ErrSorted}
+}
+
+// Desc configures the rule to check for descending order.
+func (r SortedRule) Desc() SortedRule {
+	r.desc = true
+	return r
+}
+
+// Strict configures the rule to report an error for an element equal to the previous one.
+func (r SortedRule) Strict() SortedRule {
+	r.strict = true
+	return r
+}
+
+// By configures the rule to compare the keys extracted from the elements by the given function.
+func (r SortedRule) By(key func(value interface{}) interface{}) SortedRule {
+	r.key = key
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r SortedRule) Error(message string) SortedRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SortedRule) ErrorObject(err Error) SortedRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SortedRule) Validate(value interface{}) error {
+	elements, err := sequenceElements(value)
+	if err != nil {
+		return err
+	}
+
+	order := "ascending"
+	if r.desc {
+		order = "descending"
+	}
+	if r.strict {
+		order = "strictly " + order
+	}
+
+	errs := Errors{}
+	var prev interface{}
+	for i, e := range elements {
+		key := e.value
+		if r.key != nil {
+			key = r.key(key)
+		}
+		if i > 0 {
+			c, err := compareValues(prev, key)
+			if err != nil {
+				return err
+			}
+			if r.desc {
+				c = -c
+			}
+			if c > 0 || c == 0 && r.strict {
+				errs[e.name] = r.err.SetParams(map[string]interface{}{"order": order})
+			}
+		}
+		prev = key
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// NonOverlapping returns a validation rule that checks if the ranges represented by the elements of a slice
+// or array do not overlap. The start and end of the range of an element are extracted by the given functions.
+// The ranges are half-open, i.e. a range may start where another one ends; call Inclusive to treat the ends
+// as part of the ranges. Only int, uint, float, string and time.Time values can be compared.
+// An error is reported for each element whose range starts before the end of the range of another element
+// that does not start later, with the name of that element in the "overlaps" parameter. For example,
+//
+//	validation.NonOverlapping(
+//	    func(value interface{}) interface{} { return value.(Window).From },
+//	    func(value interface{}) interface{} { return value.(Window).To },
+//	)
+func NonOverlapping(start, end func(value interface{}) interface{}) NonOverlappingRule {
+	return NonOverlappingRule{start: start, end: end, err: ErrOverlapping}
+}
+
+// Inclusive configures the rule to treat the ranges as closed, i.e. a range starting where another one ends overlaps it.
+func (r NonOverlappingRule) Inclusive() NonOverlappingRule {
+	r.inclusive = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r NonOverlappingRule) Error(message string) NonOverlappingRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NonOverlappingRule) ErrorObject(err Error) NonOverlappingRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NonOverlappingRule) Validate(value interface{}) error {
+	elements, err := sequenceElements(value)
+	if err != nil {
+		return err
+	}
+
+	type span struct {
+		name       string
+		start, end interface{}
+	}
+	spans := make([]span, len(elements))
+	for i, e := range elements {
+		spans[i] = span{name: e.name, start: r.start(e.value), end: r.end(e.value)}
+	}
+	var cmpErr error
+	sort.SliceStable(spans, func(i, j int) bool {
+		c, err := compareValues(spans[i].start, spans[j].start)
+		if err != nil {
+			cmpErr = err
+		}
+		return c < 0
+	})
+	if cmpErr != nil {
+		return cmpErr
+	}
+
+	errs := Errors{}
+	for i, last := 1, 0; i < len(spans); i++ {
+		// last is the span with the latest end among the spans starting no later than the current one
+		c, err := compareValues(spans[i].start, spans[last].end)
+		if err != nil {
+			return err
+		}
+		if c < 0 || c == 0 && r.inclusive {
+			errs[spans[i].name] = r.err.SetParams(map[string]interface{}{"overlaps": spans[last].name})
+		}
+		if c, err = compareValues(spans[i].end, spans[last].end); err != nil {
+			return err
+		} else if c > 0 {
+			last = i
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// sequenceElements returns the elements of a slice or array.
+func sequenceElements(value interface{}) ([]element, error) {
+	if k := reflect.ValueOf(value).Kind(); k != reflect.Slice && k != reflect.Array {
+		return nil, errors.New("must be a slice or array")
+	}
+	return iterableElements(value)
+}
+
+// compareValues compares two values of the same kind and returns -1, 0 or 1 if a is less than,
+// equal to or greater than b respectively. Only int, uint, float, string and time.Time values are supported,
+// and pointers are compared by the values they refer to. Nil values cannot be compared.
+func compareValues(a, b interface{}) (int, error) {
+	a, aNil := Indirect(a)
+	b, bNil := Indirect(b)
+	if aNil || bNil {
+		return 0, errors.New("cannot compare nil values")
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		if !ok {
+			return 0, fmt.Errorf("cannot compare %v with %v", va.Type(), reflect.TypeOf(b))
+		}
+		return ta.Compare(tb), nil
+	}
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x := va.Int()
+		y, err := ToInt(b)
+		if err != nil {
+			return 0, err
+		}
+		return compareOrdered(x, y), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x := va.Uint()
+		y, err := ToUint(b)
+		if err != nil {
+			return 0, err
+		}
+		return compareOrdered(x, y), nil
+	case reflect.Float32, reflect.Float64:
+		x := va.Float()
+		y, err := ToFloat(b)
+		if err != nil {
+			return 0, err
+		}
+		return compareOrdered(x, y), nil
+	case reflect.String:
+		if vb.Kind() != reflect.String {
+			return 0, fmt.Errorf("cannot compare %v with %v", va.Type(), reflect.TypeOf(b))
+		}
+		return compareOrdered(va.String(), vb.String()), nil
+	}
+	return 0, fmt.Errorf("type not supported: %v", reflect.TypeOf(a))
+}
+
+func compareOrdered[T int64 | uint64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,92 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeWindow struct {
+	From, To time.Time
+}
+
+func TestSorted(t *testing.T) {
+	one, two := 1, 2
+	d := func(day int) time.Time { return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC) }
+	version := func(value interface{}) interface{} { return value.(timeWindow).From }
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  validation.Rule
+		err   string
+	}{
+		{"t1.1", []int{1, 2, 2, 3}, validation.Sorted(), ""},
+		{"t1.2", []int{1, 3, 2, 3, 1}, validation.Sorted(), "2: must be in ascending order; 4: must be in ascending order."},
+		{"t1.3", []int{1, 2, 2, 3}, validation.Sorted().Strict(), "2: must be in strictly ascending order."},
+		{"t1.4", []uint{3, 2, 2}, validation.Sorted().Desc(), ""},
+		{"t1.5", [3]float64{3, 2, 2}, validation.Sorted().Desc().Strict(), "2: must be in strictly descending order."},
+		{"t1.6", []string{"a", "b", "B"}, validation.Sorted(), "2: must be in ascending order."},
+		{"t1.7", []*int{&one, &two, &one}, validation.Sorted(), "2: must be in ascending order."},
+		{"t1.8", []time.Time{d(1), d(3), d(2)}, validation.Sorted(), "2: must be in ascending order."},
+		{"t1.9", []int{}, validation.Sorted(), ""},
+		// key function
+		{"t2.1", []timeWindow{{From: d(1)}, {From: d(5)}, {From: d(3)}}, validation.Sorted().By(version), "2: must be in ascending order."},
+		{"t2.2", []timeWindow{{From: d(1)}, {From: d(5)}}, validation.Sorted().By(version).Error("windows must be sorted"), ""},
+		// invalid values
+		{"t3.1", map[string]int{"a": 1}, validation.Sorted(), "must be a slice or array"},
+		{"t3.2", []interface{}{1, "a"}, validation.Sorted(), "cannot convert string to int64"},
+		{"t3.3", []bool{true, false}, validation.Sorted(), "type not supported: bool"},
+		{"t3.4", []interface{}{d(1), 1}, validation.Sorted(), "cannot compare time.Time with int"},
+		{"t3.5", []*int{&one, nil}, validation.Sorted(), "cannot compare nil values"},
+		{"t3.6", []*int{nil, &one}, validation.Sorted(), "cannot compare nil values"},
+		{"t3.7", []interface{}{1, nil}, validation.Sorted(), "cannot compare nil values"},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestNonOverlapping(t *testing.T) {
+	d := func(day int) time.Time { return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC) }
+	w := func(from, to int) timeWindow { return timeWindow{From: d(from), To: d(to)} }
+	rule := validation.NonOverlapping(
+		func(value interface{}) interface{} { return value.(timeWindow).From },
+		func(value interface{}) interface{} { return value.(timeWindow).To },
+	)
+	pairs := validation.NonOverlapping(
+		func(value interface{}) interface{} { return value.([2]int)[0] },
+		func(value interface{}) interface{} { return value.([2]int)[1] },
+	)
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  validation.Rule
+		err   string
+	}{
+		{"t1.1", []timeWindow{w(1, 3), w(3, 5), w(5, 6)}, rule, ""},
+		{"t1.2", []timeWindow{w(5, 6), w(1, 3), w(3, 5)}, rule, ""},
+		{"t1.3", []timeWindow{w(1, 3), w(3, 5)}, rule.Inclusive(), "1: must not overlap with 0."},
+		{"t1.4", []timeWindow{w(1, 4), w(3, 5), w(10, 12)}, rule, "1: must not overlap with 0."},
+		{"t1.5", []timeWindow{w(10, 12), w(3, 5), w(1, 4)}, rule, "1: must not overlap with 2."},
+		{"t1.6", []timeWindow{}, rule, ""},
+		// an element contained in a long one
+		{"t2.1", [][2]int{{1, 10}, {2, 3}, {4, 5}, {11, 12}}, pairs, "1: must not overlap with 0; 2: must not overlap with 0."},
+		{"t2.2", [][2]int{{1, 10}, {2, 3}}, pairs.Error("overlaps {{.overlaps}}"), "1: overlaps 0."},
+		// invalid values
+		{"t3.1", 123, pairs, "must be a slice or array"},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.Validate([]timeWindow{w(1, 4), w(3, 5)}, rule)
+	if assert.IsType(t, validation.Errors{}, err) {
+		e := err.(validation.Errors)["1"].(validation.Error)
+		assert.Equal(t, "validation_overlapping", e.Code())
+		assert.Equal(t, "0", e.Params()["overlaps"])
+	}
+}
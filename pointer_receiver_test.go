@@ -0,0 +1,106 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type PtrItem struct {
+	SKU string `json:"sku"`
+}
+
+func (i *PtrItem) Validate() error {
+	return validation.ValidateStruct(i, validation.Field(&i.SKU, validation.Required))
+}
+
+type PtrItemWithContext struct {
+	SKU string `json:"sku"`
+}
+
+func (i *PtrItemWithContext) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, i, validation.Field(&i.SKU, validation.Required))
+}
+
+type PtrOrder struct {
+	Item     PtrItem            `json:"item"`
+	Items    []PtrItem          `json:"items"`
+	Context  PtrItemWithContext `json:"context"`
+	Optional *PtrItem           `json:"optional"`
+}
+
+func TestValidatePointerReceiverElements(t *testing.T) {
+	items := []PtrItem{{SKU: "a"}, {}}
+	array := [2]PtrItem{{}, {SKU: "b"}}
+
+	assert.EqualError(t, validation.Validate(items), "1: (sku: cannot be blank.).")
+	assert.EqualError(t, validation.Validate(&array), "0: (sku: cannot be blank.).")
+	assert.Nil(t, validation.Validate(array), "array elements that are not addressable cannot be validated")
+	assert.Nil(t, validation.Validate([]PtrItem{{SKU: "a"}}))
+
+	ctx := context.Background()
+	assert.EqualError(t, validation.ValidateWithContext(ctx, items), "1: (sku: cannot be blank.).")
+	assert.EqualError(t, validation.ValidateWithContext(ctx, &array), "0: (sku: cannot be blank.).")
+	err := validation.ValidateWithContext(ctx, []PtrItemWithContext{{}, {SKU: "c"}})
+	assert.EqualError(t, err, "0: (sku: cannot be blank.).")
+
+	// map elements are not addressable
+	assert.Nil(t, validation.Validate(map[string]PtrItem{"a": {}}))
+}
+
+type PtrHolder struct {
+	Arr [2]PtrItem            `json:"arr"`
+	Ctx [1]PtrItemWithContext `json:"ctx"`
+}
+
+func TestValidateStructPointerReceiverArrayFields(t *testing.T) {
+	h := PtrHolder{}
+	err := validation.ValidateStruct(&h, validation.Field(&h.Arr), validation.Field(&h.Ctx))
+	assert.EqualError(t, err, "arr: (0: (sku: cannot be blank.); 1: (sku: cannot be blank.).); ctx: (0: (sku: cannot be blank.).).")
+	assert.Equal(t, validation.Validate(&h.Arr), err.(validation.Errors)["arr"])
+
+	err = validation.ValidateStructWithContext(context.Background(), &h, validation.Field(&h.Arr), validation.Field(&h.Ctx))
+	assert.EqualError(t, err, "arr: (0: (sku: cannot be blank.); 1: (sku: cannot be blank.).); ctx: (0: (sku: cannot be blank.).).")
+
+	h.Arr[0].SKU, h.Arr[1].SKU, h.Ctx[0].SKU = "a", "b", "c"
+	assert.Nil(t, validation.ValidateStruct(&h, validation.Field(&h.Arr), validation.Field(&h.Ctx)))
+	h = PtrHolder{}
+	assert.Nil(t, validation.ValidateStruct(&h, validation.Field(&h.Arr, validation.Skip)))
+}
+
+func TestValidateStructPointerReceiverFields(t *testing.T) {
+	o := PtrOrder{Items: []PtrItem{{}}}
+	err := validation.ValidateStruct(&o,
+		validation.Field(&o.Item),
+		validation.Field(&o.Items),
+		validation.Field(&o.Context),
+		validation.Field(&o.Optional),
+	)
+	assert.EqualError(t, err, "context: (sku: cannot be blank.); item: (sku: cannot be blank.); items: (0: (sku: cannot be blank.).).")
+
+	// the rules of the field are applied first
+	err = validation.ValidateStruct(&o, validation.Field(&o.Item, validation.By(func(interface{}) error {
+		return errors.New("invalid item")
+	})))
+	assert.EqualError(t, err, "item: invalid item.")
+
+	// Skip prevents validating the field by its methods
+	err = validation.ValidateStruct(&o, validation.Field(&o.Item, validation.Skip))
+	assert.Nil(t, err)
+
+	o = PtrOrder{Item: PtrItem{SKU: "a"}, Context: PtrItemWithContext{SKU: "b"}, Optional: &PtrItem{}}
+	err = validation.ValidateStructWithContext(context.Background(), &o,
+		validation.Field(&o.Item),
+		validation.Field(&o.Context),
+		validation.Field(&o.Optional),
+	)
+	assert.EqualError(t, err, "optional: (sku: cannot be blank.).")
+
+	// errors of an embedded field are merged
+	e := struct{ PtrItemWithContext }{}
+	err = validation.ValidateStruct(&e, validation.Field(&e.PtrItemWithContext))
+	assert.EqualError(t, err, "sku: cannot be blank.")
+}
@@ -0,0 +1,226 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+var (
+	// ErrSome is the error that returns when no element of an iterable satisfies the rules.
+	ErrSome = NewError("validation_some", "must contain at least one matching element")
+	// ErrAtLeastN is the error that returns when fewer elements of an iterable than required satisfy the rules.
+	ErrAtLeastN = NewError("validation_at_least_n", "must contain at least {{.min}} matching elements")
+	// ErrNone is the error that returns for an element of an iterable that satisfies the rules it must not satisfy.
+	ErrNone = NewError("validation_none", "must not match")
+	// ErrAtMostN is the error that returns for each element of an iterable that exceeds the allowed number of matches.
+	ErrAtMostN = NewError("validation_at_most_n", "exceeds the maximum of {{.max}} matching elements")
+	// ErrUnique is the error that returns for an element of an iterable that duplicates a previous element.
+	ErrUnique = NewError("validation_unique", "must be unique")
+)
+
+type (
+	// QuantifierRule is a validation rule that checks how many elements of an iterable (map, slice or array)
+	// satisfy a list of rules. An element satisfies the rules if validating it with them succeeds.
+	QuantifierRule struct {
+		min, max int // max < 0 means no upper limit
+		rules    []Rule
+		err      Error
+	}
+
+	// UniqueRule is a validation rule that checks if the elements of an iterable (map, slice or array) are unique.
+	UniqueRule struct {
+		key func(value interface{}) interface{}
+		err Error
+	}
+
+	// element is an element of an iterable along with the name used to report its error.
+	element struct {
+		name  string
+		value interface{}
+	}
+)
+
+// Some returns a validation rule that checks if at least one element of an iterable satisfies the given rules.
+// For example, the following rule checks if at least one address is primary:
+//
+//	validation.Some(validation.By(isPrimaryAddress))
+//
+// An empty iterable is considered valid. Use the Required rule to make sure the iterable is not empty.
+func Some(rules ...Rule) QuantifierRule {
+	return QuantifierRule{min: 1, max: -1, rules: rules, err: ErrSome}
+}
+
+// AtLeastN returns a validation rule that checks if at least n elements of an iterable satisfy the given rules.
+// An empty iterable is considered valid. Use the Required rule to make sure the iterable is not empty.
+func AtLeastN(n int, rules ...Rule) QuantifierRule {
+	return QuantifierRule{min: n, max: -1, rules: rules, err: ErrAtLeastN}
+}
+
+// None returns a validation rule that checks if no element of an iterable satisfies the given rules.
+// An error is reported for each element that satisfies them.
+func None(rules ...Rule) QuantifierRule {
+	return QuantifierRule{min: 0, max: 0, rules: rules, err: ErrNone}
+}
+
+// AtMostN returns a validation rule that checks if at most n elements of an iterable satisfy the given rules.
+// An error is reported for each element that satisfies them after the first n ones.
+// Map elements are examined in the order of the names of their keys.
+func AtMostN(n int, rules ...Rule) QuantifierRule {
+	return QuantifierRule{min: 0, max: n, rules: rules, err: ErrAtMostN}
+}
+
+// Error sets the error message for the rule.
+func (r QuantifierRule) Error(message string) QuantifierRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r QuantifierRule) ErrorObject(err Error) QuantifierRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r QuantifierRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.TODO(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+func (r QuantifierRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	elements, err := iterableElements(value)
+	if err != nil || len(elements) == 0 {
+		return err
+	}
+
+	errs := Errors{}
+	matches := 0
+	for _, e := range elements {
+		var err error
+		if ctx == nil {
+			err = Validate(e.value, r.rules...)
+		} else {
+			err = ValidateWithContext(ctx, e.value, r.rules...)
+		}
+		if err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			continue
+		}
+		if matches++; r.max >= 0 && matches > r.max {
+			errs[e.name] = r.err.SetParams(map[string]interface{}{"max": r.max})
+		}
+	}
+
+	if matches < r.min {
+		return r.err.SetParams(map[string]interface{}{"min": r.min})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Unique returns a validation rule that checks if the elements of an iterable (map, slice or array) are unique.
+// Pointers are compared by the values they refer to. An error is reported for each element that duplicates
+// a previous one, with the name of the previous element in the "duplicate" parameter.
+// Map elements are examined in the order of the names of their keys.
+func Unique() UniqueRule {
+	return UniqueRule{err: ErrUnique}
+}
+
+// UniqueBy returns a validation rule that checks if the keys extracted from the elements of an iterable are unique.
+// For example, the following rule checks if the SKUs of a slice of items are unique:
+//
+//	validation.UniqueBy(func(value interface{}) interface{} { return value.(Item).SKU })
+//
+// Please refer to Unique for how the errors are reported.
+func UniqueBy(key func(value interface{}) interface{}) UniqueRule {
+	return UniqueRule{key: key, err: ErrUnique}
+}
+
+// Error sets the error message for the rule.
+func (r UniqueRule) Error(message string) UniqueRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r UniqueRule) ErrorObject(err Error) UniqueRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r UniqueRule) Validate(value interface{}) error {
+	elements, err := iterableElements(value)
+	if err != nil {
+		return err
+	}
+
+	errs := Errors{}
+	seen := make(map[interface{}]string, len(elements))
+	for _, e := range elements {
+		key := e.value
+		if r.key != nil {
+			key = r.key(key)
+		}
+		if key != nil && !reflect.TypeOf(key).Comparable() {
+			return fmt.Errorf("cannot compare elements of type %v", reflect.TypeOf(key))
+		}
+		first, dup, err := markSeen(seen, key, e.name)
+		if err != nil {
+			return err
+		}
+		if dup {
+			errs[e.name] = r.err.SetParams(map[string]interface{}{"duplicate": first})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// markSeen records in seen that the element of the given name has the given key, unless an element with the
+// same key was seen before, whose name is returned instead along with true. It returns an error if the key cannot be hashed,
+// such as a struct whose interface field holds a slice, which is only known when hashing it.
+func markSeen(seen map[interface{}]string, key interface{}, name string) (first string, dup bool, err error) {
+	defer func() {
+		if recover() != nil {
+			err = fmt.Errorf("cannot compare elements of type %v", reflect.TypeOf(key))
+		}
+	}()
+	if first, ok := seen[key]; ok {
+		return first, true, nil
+	}
+	seen[key] = name
+	return "", false, nil
+}
+
+// iterableElements returns the elements of a map, slice or array in a deterministic order.
+// Map elements are sorted by the names of their keys.
+func iterableElements(value interface{}) ([]element, error) {
+	var elements []element
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			elements = append(elements, element{name: EachRule{}.getString(k), value: EachRule{}.getInterface(v.MapIndex(k))})
+		}
+		sort.SliceStable(elements, func(i, j int) bool { return elements[i].name < elements[j].name })
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			elements = append(elements, element{name: strconv.Itoa(i), value: EachRule{}.getInterface(v.Index(i))})
+		}
+	default:
+		return nil, errors.New("must be an iterable (map, slice or array)")
+	}
+	return elements, nil
+}
@@ -0,0 +1,108 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type lineItem struct {
+	SKU      string
+	Quantity int
+	Gift     bool
+}
+
+func TestQuantifierRule(t *testing.T) {
+	gift := validation.By(func(value interface{}) error {
+		if !value.(lineItem).Gift {
+			return errors.New("not a gift")
+		}
+		return nil
+	})
+	negative := validation.By(func(value interface{}) error {
+		if value.(lineItem).Quantity >= 0 {
+			return errors.New("not negative")
+		}
+		return nil
+	})
+	items := []lineItem{{"a", 1, true}, {"b", -1, false}, {"c", 2, true}, {"d", -3, true}}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  validation.Rule
+		err   string
+	}{
+		{"t1.1", items, validation.Some(gift), ""},
+		{"t1.2", items[1:2], validation.Some(gift), "must contain at least one matching element"},
+		{"t1.3", []lineItem{}, validation.Some(gift), ""},
+		{"t1.4", 123, validation.Some(gift), "must be an iterable (map, slice or array)"},
+		{"t2.1", items, validation.AtLeastN(3, gift), ""},
+		{"t2.2", items, validation.AtLeastN(4, gift), "must contain at least 4 matching elements"},
+		{"t3.1", items[0:1], validation.None(negative), ""},
+		{"t3.2", items, validation.None(negative), "1: must not match; 3: must not match."},
+		{"t4.1", items, validation.AtMostN(3, gift), ""},
+		{"t4.2", items, validation.AtMostN(1, gift), "2: exceeds the maximum of 1 matching elements; 3: exceeds the maximum of 1 matching elements."},
+		{"t4.3", map[string]lineItem{"z": items[0], "y": items[2], "x": items[3]}, validation.AtMostN(2, gift), "z: exceeds the maximum of 2 matching elements."},
+		{"t4.4", []*lineItem{&items[0], nil, &items[2]}, validation.AtMostN(1, validation.NotNil), "2: exceeds the maximum of 1 matching elements."},
+		// nested rule chains
+		{"t5.1", [][]string{{"a"}, {"b", ""}}, validation.Some(validation.Each(validation.Required)), ""},
+		{"t5.2", [][]string{{""}, {"b", ""}}, validation.Some(validation.Each(validation.Required)), "must contain at least one matching element"},
+		// custom error
+		{"t6.1", items[1:2], validation.Some(gift).Error("needs a gift"), "needs a gift"},
+		{"t6.2", items, validation.None(negative).ErrorObject(validation.NewError("neg", "negative quantity")), "1: negative quantity; 3: negative quantity."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateWithContext(context.Background(), test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.Validate([]string{"a", "internal"}, validation.Some(&validateInternalError{}))
+	assert.EqualError(t, err, "error internal")
+}
+
+// anyHolder is comparable, but cannot be hashed if X holds an uncomparable value.
+type anyHolder struct {
+	X interface{}
+}
+
+func TestUniqueRule(t *testing.T) {
+	a, b := "a", "b"
+	sku := func(value interface{}) interface{} { return value.(lineItem).SKU }
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  validation.Rule
+		err   string
+	}{
+		{"t1.1", []string{"a", "b", "c"}, validation.Unique(), ""},
+		{"t1.2", []string{"a", "b", "a", "c", "a"}, validation.Unique(), "2: must be unique; 4: must be unique."},
+		{"t1.3", [3]int{1, 2, 2}, validation.Unique(), "2: must be unique."},
+		{"t1.4", map[string]int{"x": 1, "y": 2, "z": 1}, validation.Unique(), "z: must be unique."},
+		{"t1.5", []*string{&a, &b, &a, nil, nil}, validation.Unique(), "2: must be unique; 4: must be unique."},
+		{"t1.6", []interface{}{1, "1", 1.0, 1}, validation.Unique(), "3: must be unique."},
+		{"t1.7", [][]int{{1}, {1}}, validation.Unique(), "cannot compare elements of type []int"},
+		{"t1.8", "abc", validation.Unique(), "must be an iterable (map, slice or array)"},
+		{"t1.9", []anyHolder{{[]int{1}}, {[]int{1}}}, validation.Unique(), "cannot compare elements of type validation_test.anyHolder"},
+		{"t1.10", []interface{}{1, []int{1}}, validation.Unique(), "cannot compare elements of type []int"},
+		{"t1.11", []anyHolder{{1}, {"a"}, {1}}, validation.Unique(), "2: must be unique."},
+		{"t1.12", map[string]int{"": 1, "a": 1}, validation.Unique(), "a: must be unique."},
+		{"t2.1", []lineItem{{"a", 1, true}, {"b", 1, true}}, validation.UniqueBy(sku), ""},
+		{"t2.2", []lineItem{{"a", 1, true}, {"b", 1, true}, {"a", 2, false}}, validation.UniqueBy(sku), "2: must be unique."},
+		{"t2.3", []lineItem{{"a", 1, true}, {"a", 2, true}}, validation.UniqueBy(sku).Error("duplicate SKU"), "1: duplicate SKU."},
+	}
+	for _, test := range tests {
+		err := validation.Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.Validate([]string{"a", "b", "a"}, validation.Unique())
+	if assert.IsType(t, validation.Errors{}, err) {
+		assert.Equal(t, map[string]interface{}{"duplicate": "0"}, err.(validation.Errors)["2"].(validation.Error).Params())
+	}
+}
@@ -0,0 +1,113 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// DefaultMaxDepth is the maximum number of nested values ValidateWithContext descends into by default.
+const DefaultMaxDepth = 1000
+
+type (
+	// ErrCycle is the error that a value being validated refers back to itself.
+	ErrCycle struct {
+		// Type is the type of the value that is reached again.
+		Type reflect.Type
+	}
+
+	// ErrDepthExceeded is the error that values are nested deeper than the maximum depth.
+	ErrDepthExceeded int
+
+	maxDepthKey struct{}
+	visitKey    struct{}
+
+	// visit is a value ValidateWithContext has descended into, linked to the value it descended from.
+	visit struct {
+		typ    reflect.Type
+		ptr    uintptr
+		len    int
+		depth  int
+		parent *visit
+	}
+)
+
+// Error returns the error string of ErrCycle.
+func (e ErrCycle) Error() string {
+	return fmt.Sprintf("cycle detected: a value of type %v refers back to itself", e.Type)
+}
+
+// Error returns the error string of ErrDepthExceeded.
+func (e ErrDepthExceeded) Error() string {
+	return fmt.Sprintf("values nested deeper than %d levels cannot be validated", int(e))
+}
+
+// WithMaxDepth returns a copy of ctx that limits the number of nested values ValidateWithContext descends into.
+// A depth that is not positive removes the limit. Without it, DefaultMaxDepth is used.
+func WithMaxDepth(ctx context.Context, depth int) context.Context {
+	return context.WithValue(ctx, maxDepthKey{}, depth)
+}
+
+// MaxDepthFromContext returns the maximum depth carried by ctx, or DefaultMaxDepth if there is none.
+func MaxDepthFromContext(ctx context.Context) int {
+	if depth, ok := ctx.Value(maxDepthKey{}).(int); ok {
+		return depth
+	}
+	return DefaultMaxDepth
+}
+
+// enterValue records in the returned context that ValidateWithContext descends into rv.
+// It returns an ErrCycle internal error if rv is a pointer, map or slice that is already being validated
+// by an enclosing call, and an ErrDepthExceeded internal error if the maximum depth is exceeded.
+// The run is tracked only as long as the context is passed along, e.g. to ValidatableWithContext implementations.
+func enterValue(ctx context.Context, rv reflect.Value) (context.Context, error) {
+	if ctx == nil {
+		return ctx, nil
+	}
+	parent, _ := ctx.Value(visitKey{}).(*visit)
+	v := &visit{typ: rv.Type(), depth: 1, parent: parent}
+	if parent != nil {
+		v.depth = parent.depth + 1
+	}
+	if max := MaxDepthFromContext(ctx); max > 0 && v.depth > max {
+		return ctx, NewInternalError(ErrDepthExceeded(max))
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.Type().Elem().Size() > 0 {
+			// pointers to zero-sized values may share the same address
+			v.ptr = rv.Pointer()
+		}
+	case reflect.Map:
+		v.ptr = rv.Pointer()
+	case reflect.Slice:
+		v.ptr, v.len = rv.Pointer(), rv.Len()
+	}
+	if v.ptr != 0 {
+		for p := parent; p != nil; p = p.parent {
+			if p.ptr == v.ptr && p.len == v.len && p.typ == v.typ {
+				return ctx, NewInternalError(ErrCycle{Type: v.typ})
+			}
+		}
+	}
+	return context.WithValue(ctx, visitKey{}, v), nil
+}
+
+// canDescend checks if ValidateWithContext descends into the given non-nil value after applying the rules.
+func canDescend(rv reflect.Value) bool {
+	t := rv.Type()
+	if t.Implements(validatableWithContextType) || t.Implements(validatableType) {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return true
+	case reflect.Map:
+		return t.Elem().Implements(validatableWithContextType) || t.Elem().Implements(validatableType)
+	case reflect.Slice, reflect.Array:
+		return t.Elem().Implements(validatableWithContextType) || t.Elem().Implements(validatableType) ||
+			isPointerValidatable(t.Elem())
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type TreeNode struct {
+	Name     string      `json:"name"`
+	Parent   *TreeNode   `json:"parent"`
+	Children []*TreeNode `json:"children"`
+}
+
+func (n *TreeNode) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, n,
+		validation.Field(&n.Name, validation.Required),
+		validation.Field(&n.Parent),
+		validation.Field(&n.Children),
+	)
+}
+
+func TestValidateWithContextCycle(t *testing.T) {
+	root := &TreeNode{Name: "root"}
+	child := &TreeNode{Name: "child", Parent: root}
+	root.Children = []*TreeNode{child}
+
+	err := validation.ValidateWithContext(context.Background(), root)
+	if assert.Error(t, err) {
+		ie, ok := err.(validation.InternalError)
+		if assert.True(t, ok) {
+			var e validation.ErrCycle
+			assert.True(t, errors.As(ie.InternalError(), &e))
+		}
+		assert.Equal(t, "cycle detected: a value of type *validation_test.TreeNode refers back to itself", err.Error())
+	}
+
+	// shared nodes that do not form a cycle are valid
+	leaf := &TreeNode{Name: "leaf"}
+	dag := &TreeNode{Name: "dag", Children: []*TreeNode{{Name: "a", Children: []*TreeNode{leaf}}, {Name: "b", Children: []*TreeNode{leaf}}}}
+	assert.Nil(t, validation.ValidateWithContext(context.Background(), dag))
+
+	leaf.Name = ""
+	err = validation.ValidateWithContext(context.Background(), dag)
+	assert.EqualError(t, err, "children: (0: (children: (0: (name: cannot be blank.).).); 1: (children: (0: (name: cannot be blank.).).).).")
+}
+
+type ListNode struct {
+	Name string
+	Next *ListNode
+}
+
+func (n *ListNode) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, n,
+		validation.Field(&n.Name, validation.Required),
+		validation.Field(&n.Next),
+	)
+}
+
+func TestValidateWithContextSelfCycle(t *testing.T) {
+	n := &ListNode{Name: "a"}
+	n.Next = &ListNode{Name: "b", Next: n}
+	self := &ListNode{Name: "self"}
+	self.Next = self
+
+	for _, err := range []error{
+		validation.ValidateWithContext(context.Background(), self),
+		validation.ValidateWithContext(context.Background(), n),
+		validation.ValidateWithContext(context.Background(), []*ListNode{n}),
+		validation.ValidateWithContext(context.Background(), map[string]*ListNode{"a": n}),
+	} {
+		if assert.Error(t, err) {
+			ie, ok := err.(validation.InternalError)
+			if assert.True(t, ok) {
+				assert.Equal(t, validation.ErrCycle{Type: reflect.TypeOf(n)}, ie.InternalError())
+			}
+		}
+	}
+
+	// the same value may be validated again once its validation is done
+	n.Next.Next = nil
+	assert.Nil(t, validation.ValidateWithContext(context.Background(), []*ListNode{n, n}))
+	n.Next.Name = ""
+	assert.EqualError(t, validation.ValidateWithContext(context.Background(), n), "Next: (Name: cannot be blank.).")
+}
+
+func TestValidateWithContextMaxDepth(t *testing.T) {
+	chain := &TreeNode{Name: "0"}
+	for i := 0; i < 10; i++ {
+		chain = &TreeNode{Name: "n", Children: []*TreeNode{chain}}
+	}
+
+	assert.Nil(t, validation.ValidateWithContext(context.Background(), chain))
+	assert.Nil(t, validation.ValidateWithContext(validation.WithMaxDepth(context.Background(), 0), chain))
+
+	err := validation.ValidateWithContext(validation.WithMaxDepth(context.Background(), 10), chain)
+	if assert.Error(t, err) {
+		ie, ok := err.(validation.InternalError)
+		if assert.True(t, ok) {
+			assert.Equal(t, validation.ErrDepthExceeded(10), ie.InternalError())
+		}
+		assert.Equal(t, "values nested deeper than 10 levels cannot be validated", err.Error())
+	}
+
+	assert.Equal(t, validation.DefaultMaxDepth, validation.MaxDepthFromContext(context.Background()))
+	assert.Equal(t, 5, validation.MaxDepthFromContext(validation.WithMaxDepth(context.Background(), 5)))
+}
@@ -0,0 +1,111 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+type (
+	// StructRule is a validation rule for the struct type T built from a list of field selectors.
+	// It is compiled once by Struct and may be reused to validate any number of values of type T.
+	// A StructRule is safe for concurrent use.
+	StructRule[T any] struct {
+		fields []structField[T]
+	}
+
+	// SelectorRules represents a rule set associated with a struct field that is chosen by a selector function.
+	SelectorRules[T any] struct {
+		selector func(*T) interface{}
+		rules    []Rule
+	}
+
+	// structField is a struct field resolved from a selector when compiling a StructRule.
+	structField[T any] struct {
+		field    reflect.StructField
+		selector func(*T) interface{}
+		rules    []Rule
+	}
+)
+
+// F specifies a struct field of T by a selector function and the corresponding validation rules.
+// The selector must return the address of a field of the struct it is given. For example,
+//
+//	validation.F(func(u *User) *string { return &u.Name }, validation.Required)
+func F[T any, V any](selector func(*T) *V, rules ...Rule) *SelectorRules[T] {
+	return &SelectorRules[T]{
+		selector: func(t *T) interface{} { return selector(t) },
+		rules:    rules,
+	}
+}
+
+// Struct compiles a validation rule for the struct type T from the given field selectors.
+// Unlike ValidateStruct, the returned rule does not capture a particular struct value, so it can be built
+// once (e.g. as a package-level variable) and used to validate any *T or T. For example,
+//
+//	var userRule = validation.Struct(
+//	    validation.F(func(u *User) *string { return &u.Name }, validation.Required),
+//	    validation.F(func(u *User) *int { return &u.Age }, validation.Min(18)),
+//	)
+//
+//	err := validation.Validate(&user, userRule)
+//
+// The selectors are resolved against a zero value of T when Struct is called, and Struct panics if T
+// is not a struct or if a selector does not return the address of a field of T. Fields promoted through
+// embedded struct pointers cannot be resolved this way and are therefore not supported.
+func Struct[T any](fields ...*SelectorRules[T]) StructRule[T] {
+	if t := reflect.TypeOf((*T)(nil)).Elem(); t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validation: Struct cannot be used with the non-struct type %v", t))
+	}
+	zero := new(T)
+	value := reflect.ValueOf(zero).Elem()
+	r := StructRule[T]{fields: make([]structField[T], len(fields))}
+	for i, sr := range fields {
+		ft := FindStructField(value, reflect.ValueOf(sr.selector(zero)))
+		if ft == nil {
+			panic(fmt.Sprintf("validation: %v", ErrFieldNotFound(i)))
+		}
+		r.fields[i] = structField[T]{field: *ft, selector: sr.selector, rules: sr.rules}
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+// The value must be either T or *T. A nil pointer is considered valid.
+func (r StructRule[T]) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.TODO(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+// The value must be either T or *T. A nil pointer is considered valid.
+func (r StructRule[T]) ValidateWithContext(ctx context.Context, value interface{}) error {
+	var s *T
+	switch v := value.(type) {
+	case *T:
+		s = v
+	case T:
+		s = &v
+	default:
+		return NewInternalError(fmt.Errorf("only a %v or a pointer to it can be validated", reflect.TypeOf(s).Elem()))
+	}
+	if s == nil {
+		// treat a nil struct pointer as valid
+		return nil
+	}
+
+	errs := Errors{}
+	c := newErrorCollector(ctx, errs)
+	for i := range r.fields {
+		if c.stop(len(r.fields) - i) {
+			break
+		}
+		f := &r.fields[i]
+		n, mark := c.count(), c.mark()
+		if err := validateStructField(ctx, reflect.ValueOf(f.selector(s)), &f.field, f.rules, errs); err != nil {
+			return err
+		}
+		c.charge(c.count()-n, mark)
+	}
+
+	return c.result()
+}
@@ -0,0 +1,98 @@
+package validation_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type SchemaUser struct {
+	Model3
+	Name    string `json:"name"`
+	Age     int
+	Tags    []string
+	Address NestedAddress
+}
+
+var schemaAddressRule = validation.Struct(
+	validation.F(func(a *NestedAddress) *string { return &a.Street }, validation.Required),
+	validation.F(func(a *NestedAddress) *string { return &a.City }, validation.Required),
+)
+
+var schemaUserRule = validation.Struct(
+	validation.F(func(u *SchemaUser) *string { return &u.Name }, validation.Required, validation.Length(2, 10)),
+	validation.F(func(u *SchemaUser) *int { return &u.Age }, validation.Min(18)),
+	validation.F(func(u *SchemaUser) *[]string { return &u.Tags }, validation.Each(validation.Required)),
+	validation.F(func(u *SchemaUser) *NestedAddress { return &u.Address }, schemaAddressRule),
+	validation.F(func(u *SchemaUser) *Model3 { return &u.Model3 }),
+)
+
+func TestStruct(t *testing.T) {
+	var u0 *SchemaUser
+	u1 := SchemaUser{Model3: Model3{A: "abc"}, Name: "John", Age: 20, Address: NestedAddress{Street: "Main", City: "Town"}}
+	u2 := SchemaUser{Name: "J", Age: 10, Tags: []string{"a", ""}}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", &u1, ""},
+		{"t2", u1, ""},
+		{"t3", u0, ""},
+		{"t4", &u2, "A: error abc; Address: (Street: cannot be blank; city: cannot be blank.); Age: must be no less than 18; Tags: (1: cannot be blank.); name: the length must be between 2 and 10."},
+		{"t5", u2, "A: error abc; Address: (Street: cannot be blank; city: cannot be blank.); Age: must be no less than 18; Tags: (1: cannot be blank.); name: the length must be between 2 and 10."},
+		{"t6", "abc", "only a validation_test.SchemaUser or a pointer to it can be validated"},
+	}
+	for _, test := range tests {
+		err := schemaUserRule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateWithContext(context.Background(), test.value, schemaUserRule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// used as a rule of a struct field
+	err := validation.ValidateStruct(&u2, validation.Field(&u2.Address, schemaAddressRule))
+	assert.EqualError(t, err, "Address: (Street: cannot be blank; city: cannot be blank.).")
+
+	// field name options
+	ctx := validation.WithFieldNameOptions(context.Background(), validation.FieldNameOptions{Mapper: validation.SnakeCase})
+	err = schemaUserRule.ValidateWithContext(ctx, &u2)
+	assert.EqualError(t, err, "a: error abc; address: (city: cannot be blank; street: cannot be blank.); age: must be no less than 18; name: the length must be between 2 and 10; tags: (1: cannot be blank.).")
+}
+
+func TestStructConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(age int) {
+			defer wg.Done()
+			u := SchemaUser{Model3: Model3{A: "abc"}, Name: "John", Age: age, Address: NestedAddress{Street: "Main", City: "Town"}}
+			err := schemaUserRule.Validate(&u)
+			if age < 18 {
+				assert.EqualError(t, err, "Age: must be no less than 18.")
+			} else {
+				assert.NoError(t, err)
+			}
+		}(i*2 + 1)
+	}
+	wg.Wait()
+}
+
+func TestStructInvalidSelector(t *testing.T) {
+	other := NestedAddress{}
+	assert.PanicsWithValue(t, "validation: field #1 cannot be found in the struct", func() {
+		validation.Struct(
+			validation.F(func(a *NestedAddress) *string { return &a.City }),
+			validation.F(func(a *NestedAddress) *string { return &other.City }),
+		)
+	})
+	assert.PanicsWithValue(t, "validation: Struct cannot be used with the non-struct type string", func() {
+		validation.Struct(
+			validation.F(func(s *string) *string { return s }),
+		)
+	})
+}
@@ -2,10 +2,16 @@ package validation
 
 type stringValidator func(string) bool
 
+// NativeValidator validates values of the types it supports natively, such as net.IP.
+// It returns whether the value is valid and whether the type of the value is supported.
+type NativeValidator func(value interface{}) (valid bool, ok bool)
+
 // StringRule is a rule that checks a string variable using a specified stringValidator.
 type StringRule struct {
 	StringValidate stringValidator
 	Err            Error
+	native         NativeValidator
+	text           bool
 }
 
 // NewStringRule creates a new validation rule using a function that takes a string value and returns a bool.
@@ -40,6 +46,21 @@ func (r StringRule) ErrorObject(err Error) StringRule {
 	return r
 }
 
+// AllowText configures the rule to also validate values that are neither strings nor byte slices by their text
+// representations, obtained through encoding.TextMarshaler or, failing that, fmt.Stringer.
+// This allows applying string rules to types such as uuid.UUID, netip.Addr or url.URL.
+func (r StringRule) AllowText() StringRule {
+	r.text = true
+	return r
+}
+
+// WithNative sets the validator for the values whose types are supported natively, such as net.IP.
+// It is called before the value is converted to a string.
+func (r StringRule) WithNative(validator NativeValidator) StringRule {
+	r.native = validator
+	return r
+}
+
 // Validate checks if the given value is valid or not.
 func (r StringRule) Validate(value interface{}) error {
 	value, isNil := Indirect(value)
@@ -47,7 +68,19 @@ func (r StringRule) Validate(value interface{}) error {
 		return nil
 	}
 
+	if r.native != nil {
+		if valid, ok := r.native(value); ok {
+			if valid {
+				return nil
+			}
+			return r.Err
+		}
+	}
+
 	str, err := EnsureString(value)
+	if err != nil && r.text {
+		str, err = EnsureText(value)
+	}
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package validation_test
 
 import (
 	"database/sql"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -127,3 +128,76 @@ func TestStringRule_ErrorObject(t *testing.T) {
 	assert.Equal(t, "code", r.Err.Code())
 	assert.Equal(t, "abc", r.Err.Message())
 }
+
+type meText struct {
+	text string
+}
+
+func (m meText) MarshalText() ([]byte, error) {
+	if m.text == "error" {
+		return nil, errors.New("cannot marshal")
+	}
+	return []byte(m.text), nil
+}
+
+type meString struct {
+	s string
+}
+
+func (m *meString) String() string {
+	return m.s
+}
+
+func TestStringRule_AllowText(t *testing.T) {
+	v := validation.NewStringRule(validateMe, "wrong")
+
+	err := v.Validate(meText{"me"})
+	assert.EqualError(t, err, "must be either a string or byte slice")
+
+	v = v.AllowText()
+	assert.Nil(t, v.Validate(meText{"me"}))
+	assert.Nil(t, v.Validate(&meText{"me"}))
+	assert.EqualError(t, v.Validate(meText{"you"}), "wrong")
+	assert.EqualError(t, v.Validate(meText{"error"}), "cannot marshal")
+	assert.Nil(t, v.Validate(meString{"me"}), "String has a pointer receiver")
+	assert.EqualError(t, v.Validate(meString{"you"}), "wrong")
+	assert.Nil(t, v.Validate("me"))
+	assert.EqualError(t, v.Validate(100), "must be either a string, a byte slice or a value with a text representation")
+}
+
+func TestStringRule_WithNative(t *testing.T) {
+	v := validation.NewStringRule(validateMe, "wrong").WithNative(func(value interface{}) (bool, bool) {
+		if i, ok := value.(int); ok {
+			return i == 1, true
+		}
+		return false, false
+	})
+	assert.Nil(t, v.Validate(1))
+	assert.EqualError(t, v.Validate(2), "wrong")
+	assert.Nil(t, v.Validate("me"))
+	assert.EqualError(t, v.Validate("you"), "wrong")
+}
+
+func TestEnsureText(t *testing.T) {
+	tests := []struct {
+		tag    string
+		value  interface{}
+		result string
+		err    string
+	}{
+		{"t1", "abc", "abc", ""},
+		{"t2", []byte("abc"), "abc", ""},
+		{"t3", meText{"abc"}, "abc", ""},
+		{"t4", meString{"abc"}, "abc", ""},
+		{"t5", &meString{"abc"}, "abc", ""},
+		{"t6", meText{"error"}, "", "cannot marshal"},
+		{"t7", 100, "", "must be either a string, a byte slice or a value with a text representation"},
+		{"t8", nil, "", "must not be nil"},
+		{"t9", (*meString)(nil), "", "must not be nil"},
+	}
+	for _, test := range tests {
+		s, err := validation.EnsureText(test.value)
+		assert.Equal(t, test.result, s, test.tag)
+		assertError(t, test.err, err, test.tag)
+	}
+}
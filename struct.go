@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"strings"
 )
 
 var (
@@ -24,6 +23,9 @@ type (
 	FieldRules struct {
 		fieldPtr interface{}
 		rules    []Rule
+		// structRule is set for struct-level rules created by StructLevel.
+		structRule   StructLevelFunc
+		skipOnErrors bool
 	}
 )
 
@@ -41,6 +43,7 @@ func (e ErrFieldNotFound) Error() string {
 // Note that the struct being validated must be specified as a pointer to it. If the pointer is nil, it is considered valid.
 // Use Field() to specify struct fields that need to be validated. Each Field() call specifies a single field which
 // should be specified as a pointer to the field. A field can be associated with multiple rules.
+// Use StructLevel() to specify rules that involve several fields; they run after all field rules.
 // For example,
 //
 //	value := struct {
@@ -61,7 +64,8 @@ func ValidateStruct(structPtr interface{}, fields ...*FieldRules) error {
 
 // ValidateStructWithContext validates a struct with the given context.
 // The only difference between ValidateStructWithContext and ValidateStruct is that the former will
-// validate struct fields with the provided context.
+// validate struct fields with the provided context. The field names used in the returned errors
+// are resolved with the FieldNameOptions carried by the context (see WithFieldNameOptions).
 // Please refer to ValidateStruct for the detailed instructions on how to use this function.
 func ValidateStructWithContext(ctx context.Context, structPtr interface{}, fields ...*FieldRules) error {
 	value := reflect.ValueOf(structPtr)
@@ -76,8 +80,18 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 	value = value.Elem()
 
 	errs := Errors{}
+	c := newErrorCollector(ctx, errs)
 
+	var structRules []int
 	for i, fr := range fields {
+		if fr.structRule != nil {
+			// struct-level rules run after all field rules
+			structRules = append(structRules, i)
+			continue
+		}
+		if c.stop(1) {
+			continue
+		}
 		fv := reflect.ValueOf(fr.fieldPtr)
 		if fv.Kind() != reflect.Ptr {
 			return NewInternalError(ErrFieldPointer(i))
@@ -86,32 +100,71 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 		if ft == nil {
 			return NewInternalError(ErrFieldNotFound(i))
 		}
-		var err error
+		n, mark := c.count(), c.mark()
+		if err := validateStructField(ctx, fv, ft, fr.rules, errs); err != nil {
+			return err
+		}
+		c.charge(c.count()-n, mark)
+	}
+
+	fieldErrs := len(errs) > 0
+	for _, i := range structRules {
+		if fields[i].skipOnErrors && fieldErrs || c.stop(1) {
+			continue
+		}
+		n, mark := c.count(), c.mark()
+		if err := validateStructLevel(ctx, structPtr, value, i, fields[i].structRule, errs); err != nil {
+			return err
+		}
+		c.charge(c.count()-n, mark)
+	}
+
+	return c.result()
+}
+
+// validateStructField validates the struct field referenced by fv with the given rules.
+// A validation error found is recorded in errs using the error name of the field,
+// while an internal error is returned.
+func validateStructField(ctx context.Context, fv reflect.Value, ft *reflect.StructField, rules []Rule, errs Errors) error {
+	var err error
+	if ctx == nil {
+		err = Validate(fv.Elem().Interface(), rules...)
+	} else {
+		err = ValidateWithContext(ctx, fv.Elem().Interface(), rules...)
+	}
+	if err == nil && (isPointerValidatable(ft.Type) || ft.Type.Kind() == reflect.Array && isPointerValidatable(ft.Type.Elem())) && !isSkipped(rules) {
+		// the field, including the elements of an array, is addressable,
+		// so it can be validated by the methods with pointer receivers
 		if ctx == nil {
-			err = Validate(fv.Elem().Interface(), fr.rules...)
+			err = Validate(fv.Interface())
 		} else {
-			err = ValidateWithContext(ctx, fv.Elem().Interface(), fr.rules...)
+			err = ValidateWithContext(ctx, fv.Interface())
 		}
-		if err != nil {
-			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
-				return err
+	}
+	if err == nil {
+		return nil
+	}
+	if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+		return err
+	}
+	if ft.Anonymous {
+		// merge errors from anonymous struct field
+		if es, ok := err.(Errors); ok {
+			var names map[string]string
+			if !isValidatableWithContext(fv) {
+				// the embedded struct named its fields without seeing the context
+				names = embeddedFieldNames(ctx, ft.Type)
 			}
-			if ft.Anonymous {
-				// merge errors from anonymous struct field
-				if es, ok := err.(Errors); ok {
-					for name, value := range es {
-						errs[name] = value
-					}
-					continue
+			for name, value := range es {
+				if n, ok := names[name]; ok {
+					name = n
 				}
+				errs[name] = value
 			}
-			errs[GetErrorFieldName(ft)] = err
+			return nil
 		}
 	}
-
-	if len(errs) > 0 {
-		return errs
-	}
+	errs[GetErrorFieldNameWithContext(ctx, ft)] = err
 	return nil
 }
 
@@ -155,11 +208,54 @@ func FindStructField(structValue reflect.Value, fieldValue reflect.Value) *refle
 }
 
 // GetErrorFieldName returns the name that should be used to represent the validation error of a struct field.
+// The name is taken from the ErrorTag struct tag, falling back to the Go field name.
+// Use GetErrorFieldNameWithContext to resolve the name with per-call FieldNameOptions.
 func GetErrorFieldName(f *reflect.StructField) string {
-	if tag := f.Tag.Get(ErrorTag); tag != "" && tag != "-" {
-		if cps := strings.SplitN(tag, ",", 2); cps[0] != "" {
-			return cps[0]
+	return FieldNameOptionsFromContext(context.TODO()).ErrorFieldName(f)
+}
+
+// embeddedFieldNames maps the default error names of the fields of an embedded struct type
+// to the names resolved with the field name options carried by ctx.
+// It is used to rename the errors of an embedded struct that was validated without the context.
+func embeddedFieldNames(ctx context.Context, t reflect.Type) map[string]string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	opts := FieldNameOptionsFromContext(ctx)
+	names := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous {
+			for k, v := range embeddedFieldNames(ctx, sf.Type) {
+				names[k] = v
+			}
 		}
+		if name := GetErrorFieldName(&sf); name != opts.ErrorFieldName(&sf) {
+			names[name] = opts.ErrorFieldName(&sf)
+		}
+	}
+	return names
+}
+
+// isSkipped checks if the given rules contain the Skip rule, which stops validating a value after the rules are applied.
+func isSkipped(rules []Rule) bool {
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidatableWithContext checks if the struct field referenced by fv is validated by a ValidateWithContext method.
+func isValidatableWithContext(fv reflect.Value) bool {
+	if _, ok := fv.Elem().Interface().(ValidatableWithContext); ok {
+		return true
 	}
-	return f.Name
+	// the method may have a pointer receiver
+	_, ok := fv.Interface().(ValidatableWithContext)
+	return ok
 }
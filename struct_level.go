@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+)
+
+// StructErrorKey is the key in Errors under which ValidateStruct reports the errors of struct-level rules
+// that are not attached to a particular field.
+var StructErrorKey = "_struct"
+
+type (
+	// StructLevelFunc represents a struct-level validator function.
+	// It receives the pointer to the struct being validated and returns an error if validation fails.
+	// An error created by FieldError is reported under the name of the corresponding field,
+	// Errors are merged into the result as is, and any other error is reported under StructErrorKey.
+	// Several errors can be returned by combining them with errors.Join.
+	// If an error has already been recorded under the same key, the new error is discarded.
+	StructLevelFunc func(ctx context.Context, structPtr interface{}) error
+
+	// fieldError is a struct-level error attached to a struct field.
+	fieldError struct {
+		fieldPtr interface{}
+		err      error
+	}
+)
+
+// StructLevel specifies a struct-level rule for ValidateStruct.
+// Struct-level rules check invariants that span several fields. They run after all field rules,
+// in the order in which they are specified. For example,
+//
+//	err := validation.ValidateStruct(&o,
+//	    validation.Field(&o.Total, validation.Required),
+//	    validation.StructLevel(func(ctx context.Context, _ interface{}) error {
+//	        if o.Total != o.ItemsTotal() {
+//	            return validation.FieldError(&o.Total, errors.New("must equal the sum of the line items"))
+//	        }
+//	        return nil
+//	    }).SkipOnErrors(),
+//	)
+func StructLevel(f StructLevelFunc) *FieldRules {
+	return &FieldRules{structRule: f}
+}
+
+// SkipOnErrors configures a struct-level rule to be skipped when the field rules have already found errors.
+func (r *FieldRules) SkipOnErrors() *FieldRules {
+	r.skipOnErrors = true
+	return r
+}
+
+// FieldError attaches an error returned by a struct-level rule to the struct field that fieldPtr points to.
+func FieldError(fieldPtr interface{}, err error) error {
+	return fieldError{fieldPtr: fieldPtr, err: err}
+}
+
+// Error returns the error string of the error attached to the field.
+func (e fieldError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error attached to the field.
+func (e fieldError) Unwrap() error {
+	return e.err
+}
+
+// validateStructLevel runs the i-th rule which is a struct-level rule and records its errors in errs.
+// An internal error is returned if the rule fails with one or refers to an unknown field.
+func validateStructLevel(ctx context.Context, structPtr interface{}, value reflect.Value, i int, f StructLevelFunc, errs Errors) error {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	return addStructLevelError(ctx, value, i, f(ctx, structPtr), errs)
+}
+
+func addStructLevelError(ctx context.Context, value reflect.Value, i int, err error, errs Errors) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case InternalError:
+		if e.InternalError() != nil {
+			return err
+		}
+	case fieldError:
+		fv := reflect.ValueOf(e.fieldPtr)
+		if fv.Kind() != reflect.Ptr {
+			return NewInternalError(ErrFieldPointer(i))
+		}
+		ft := FindStructField(value, fv)
+		if ft == nil {
+			return NewInternalError(ErrFieldNotFound(i))
+		}
+		addError(errs, GetErrorFieldNameWithContext(ctx, ft), e.err)
+		return nil
+	case Errors:
+		for key, value := range e {
+			addError(errs, key, value)
+		}
+		return nil
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			if err := addStructLevelError(ctx, value, i, err, errs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	addError(errs, StructErrorKey, err)
+	return nil
+}
+
+// addError records err under key unless an error has already been recorded for it.
+func addError(errs Errors, key string, err error) {
+	if _, ok := errs[key]; !ok && err != nil {
+		errs[key] = err
+	}
+}
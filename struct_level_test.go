@@ -0,0 +1,91 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prodadidb/go-validation"
+	"github.com/stretchr/testify/assert"
+)
+
+type Order struct {
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+	Items []int
+	Total int `json:"total"`
+}
+
+func TestStructLevel(t *testing.T) {
+	contact := func(o *Order) *validation.FieldRules {
+		return validation.StructLevel(func(ctx context.Context, structPtr interface{}) error {
+			if o := structPtr.(*Order); o.Email == "" && o.Phone == "" {
+				return errors.New("at least one contact method is required")
+			}
+			return nil
+		})
+	}
+	total := func(o *Order) *validation.FieldRules {
+		return validation.StructLevel(func(ctx context.Context, _ interface{}) error {
+			sum := 0
+			for _, item := range o.Items {
+				sum += item
+			}
+			if sum != o.Total {
+				return validation.FieldError(&o.Total, errors.New("must equal the sum of the items"))
+			}
+			return nil
+		})
+	}
+
+	o1 := Order{Email: "a@b.c", Items: []int{1, 2}, Total: 3}
+	o2 := Order{Items: []int{1, 2}, Total: 4}
+	o3 := Order{Items: []int{-1}, Total: -1}
+	other := Order{}
+
+	tests := []struct {
+		tag   string
+		model *Order
+		rules []*validation.FieldRules
+		err   string
+	}{
+		{"t1.1", &o1, []*validation.FieldRules{contact(&o1), total(&o1)}, ""},
+		{"t1.2", &o2, []*validation.FieldRules{contact(&o2), total(&o2)}, "_struct: at least one contact method is required; total: must equal the sum of the items."},
+		// struct-level rules run after field rules
+		{"t2.1", &o2, []*validation.FieldRules{total(&o2), validation.Field(&o2.Email, validation.Required)}, "email: cannot be blank; total: must equal the sum of the items."},
+		{"t2.2", &o2, []*validation.FieldRules{total(&o2).SkipOnErrors(), validation.Field(&o2.Email, validation.Required)}, "email: cannot be blank."},
+		{"t2.3", &o2, []*validation.FieldRules{total(&o2).SkipOnErrors(), validation.Field(&o2.Items, validation.Required)}, "total: must equal the sum of the items."},
+		// the field error wins over the struct-level error
+		{"t2.4", &o2, []*validation.FieldRules{total(&o2), validation.Field(&o2.Total, validation.Max(3))}, "total: must be no greater than 3."},
+		// several errors
+		{"t3.1", &o3, []*validation.FieldRules{validation.StructLevel(func(ctx context.Context, _ interface{}) error {
+			return errors.Join(
+				validation.FieldError(&o3.Email, validation.ErrRequired),
+				validation.FieldError(&o3.Phone, validation.ErrRequired),
+				validation.Errors{"items": errors.New("must be positive"), "nil": nil},
+			)
+		})}, "email: cannot be blank; items: must be positive; phone: cannot be blank."},
+		// invalid field
+		{"t4.1", &o1, []*validation.FieldRules{contact(&o1), validation.StructLevel(func(ctx context.Context, _ interface{}) error {
+			return validation.FieldError(&other.Total, errors.New("abc"))
+		})}, "field #1 cannot be found in the struct"},
+		{"t4.2", &o1, []*validation.FieldRules{validation.StructLevel(func(ctx context.Context, _ interface{}) error {
+			return validation.FieldError(other, errors.New("abc"))
+		})}, "field #0 must be specified as a pointer"},
+		{"t4.3", &o1, []*validation.FieldRules{validation.StructLevel(func(ctx context.Context, _ interface{}) error {
+			return validation.NewInternalError(errors.New("internal"))
+		})}, "internal"},
+	}
+
+	for _, test := range tests {
+		err := validation.ValidateStruct(test.model, test.rules...)
+		assertError(t, test.err, err, test.tag)
+		err = validation.ValidateStructWithContext(context.Background(), test.model, test.rules...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := validation.ValidateStruct(&o2, contact(&o2))
+	if assert.IsType(t, validation.Errors{}, err) {
+		assert.Contains(t, err.(validation.Errors), validation.StructErrorKey)
+	}
+}
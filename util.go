@@ -2,17 +2,56 @@ package validation
 
 import (
 	"database/sql/driver"
+	"encoding"
 	"errors"
 	"fmt"
+	"net/netip"
 	"reflect"
-	"time"
+)
+
+type (
+	// Emptier is the interface implemented by types that decide by themselves whether their values are empty.
+	// IsEmpty, and therefore rules such as Required and Empty, call IsEmpty() on such values.
+	Emptier interface {
+		IsEmpty() bool
+	}
+
+	// UnwrapFunc unwraps an option-like value for Indirect. If it recognizes the value, it returns the value
+	// being wrapped and true, where a nil value indicates that the option is not set. Otherwise it returns false.
+	UnwrapFunc func(value interface{}) (interface{}, bool)
+
+	// zeroer is implemented by types with an IsZero method, such as time.Time and netip.Addr.
+	zeroer interface {
+		IsZero() bool
+	}
 )
 
 var (
 	bytesType  = reflect.TypeOf([]byte(nil))
 	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+	unwrapFuncs []UnwrapFunc
 )
 
+// RegisterUnwrapFunc registers a function used by Indirect to unwrap option-like types, such as a generic Option[T].
+// For example,
+//
+//	validation.RegisterUnwrapFunc(func(value interface{}) (interface{}, bool) {
+//	    if o, ok := value.(interface{ Get() (string, bool) }); ok {
+//	        if v, ok := o.Get(); ok {
+//	            return v, true
+//	        }
+//	        return nil, true
+//	    }
+//	    return nil, false
+//	})
+//
+// The functions are tried in the order they are registered. RegisterUnwrapFunc is not safe for concurrent use
+// and should be called during initialization.
+func RegisterUnwrapFunc(f UnwrapFunc) {
+	unwrapFuncs = append(unwrapFuncs, f)
+}
+
 // EnsureString ensures the given value is a string.
 // If the value is a byte slice, it will be typecast into a string.
 // An error is returned otherwise.
@@ -27,6 +66,36 @@ func EnsureString(value interface{}) (string, error) {
 	return "", errors.New("must be either a string or byte slice")
 }
 
+// EnsureText returns the text representation of the given value. Strings and byte slices are returned
+// as strings, while other values must implement encoding.TextMarshaler or fmt.Stringer,
+// possibly with pointer receivers. An error is returned otherwise.
+func EnsureText(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.Kind() == reflect.Ptr && v.IsNil() {
+		return "", errors.New("must not be nil")
+	}
+	if str, err := EnsureString(value); err == nil {
+		return str, nil
+	}
+	if v.Kind() != reflect.Ptr {
+		// make the methods with pointer receivers available
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p
+	}
+	switch t := v.Interface().(type) {
+	case encoding.TextMarshaler:
+		text, err := t.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	case fmt.Stringer:
+		return t.String(), nil
+	}
+	return "", errors.New("must be either a string, a byte slice or a value with a text representation")
+}
+
 // StringOrBytes typecasts a value into a string or byte slice.
 // Boolean flags are returned to indicate if the typecasting succeeds or not.
 func StringOrBytes(value interface{}) (isString bool, str string, isBytes bool, bs []byte) {
@@ -92,9 +161,21 @@ func ToFloat(value interface{}) (float64, error) {
 // - string, array: len() == 0
 // - slice, map: nil or len() == 0
 // - interface, pointer: nil or the referenced value is empty
+// - Emptier: IsEmpty() returns true
+// - a value with an IsZero() bool method, such as time.Time: IsZero() returns true
+// - netip.Addr, netip.Prefix, netip.AddrPort: the zero value
 func IsEmpty(value interface{}) bool {
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return true
+		}
+	}
+	if e, ok := isEmptyByMethod(value); ok {
+		return e
+	}
+	switch v.Kind() {
 	case reflect.String, reflect.Array, reflect.Map, reflect.Slice:
 		return v.Len() == 0
 	case reflect.Bool:
@@ -108,23 +189,39 @@ func IsEmpty(value interface{}) bool {
 	case reflect.Invalid:
 		return true
 	case reflect.Interface, reflect.Ptr:
-		if v.IsNil() {
-			return true
-		}
 		return IsEmpty(v.Elem().Interface())
 	case reflect.Struct:
-		v, ok := value.(time.Time)
-		if ok && v.IsZero() {
-			return true
+		switch v := value.(type) {
+		case netip.Addr:
+			return !v.IsValid()
+		case netip.Prefix:
+			return !v.IsValid()
+		case netip.AddrPort:
+			return !v.IsValid()
 		}
 	}
 
 	return false
 }
 
+// isEmptyByMethod checks if a value is empty by calling its IsEmpty() or IsZero() method.
+// The second result is false if the value has neither of the methods.
+func isEmptyByMethod(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case Emptier:
+		return v.IsEmpty(), true
+	case zeroer:
+		return v.IsZero(), true
+	}
+	return false, false
+}
+
 // Indirect returns the value that the given interface or pointer references to.
+// If the value is recognized by a function registered with RegisterUnwrapFunc, it will deal with the value
+// being wrapped instead, and an option that is not set is treated as nil.
 // If the value implements driver.Valuer, it will deal with the value returned by
-// the Value() method instead. A boolean value is also returned to indicate if
+// the Value() method instead, unless the value reports itself empty through IsEmpty() or IsZero(),
+// in which case it is returned back so that IsEmpty recognizes it. A boolean value is also returned to indicate if
 // the value is nil or not (only applicable to interface, pointer, map, and slice).
 // If the value is neither an interface nor a pointer, it will be returned back.
 func Indirect(value interface{}) (interface{}, bool) {
@@ -144,7 +241,16 @@ func Indirect(value interface{}) (interface{}, bool) {
 		}
 	}
 
+	for _, f := range unwrapFuncs {
+		if v, ok := f(value); ok {
+			return Indirect(v)
+		}
+	}
+
 	if rv.Type().Implements(valuerType) {
+		if empty, ok := isEmptyByMethod(value); ok && empty {
+			return value, false
+		}
 		return indirectValuer(value.(driver.Valuer))
 	}
 
@@ -2,6 +2,9 @@ package validation_test
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -255,6 +258,17 @@ func TestIsEmpty(t *testing.T) {
 		{"t10.2", &time1, false},
 		{"t10.3", time2, true},
 		{"t10.4", &time2, true},
+		// Emptier, IsZero
+		{"t11.1", Money{}, true},
+		{"t11.2", Money{Cents: 1}, false},
+		{"t11.3", &Money{}, true},
+		{"t11.4", (*Money)(nil), true},
+		{"t11.5", netip.Addr{}, true},
+		{"t11.6", netip.MustParseAddr("127.0.0.1"), false},
+		{"t11.7", Tags{"-"}, true},
+		{"t11.8", Tags{"a"}, false},
+		{"t11.9", Tags(nil), true},
+		{"t11.10", Tags{}, false},
 	}
 
 	for _, test := range tests {
@@ -287,6 +301,13 @@ func TestIndirect(t *testing.T) {
 		{"t11", &sql.NullInt64{Int64: 0, Valid: true}, int64(0), false},
 		{"t12", &sql.NullInt64{Int64: 1, Valid: true}, int64(1), false},
 		{"t13", c, nil, true},
+		// IsZero takes precedence over driver.Valuer for empty values
+		{"t14", Money{}, Money{}, false},
+		{"t15", Money{Cents: 150}, "1.50", false},
+		// registered unwrap functions
+		{"t16", Option[int]{}, nil, true},
+		{"t17", Option[int]{value: 5, set: true}, 5, false},
+		{"t18", &Option[*int]{value: &a, set: true}, 100, false},
 	}
 
 	for _, test := range tests {
@@ -295,3 +316,60 @@ func TestIndirect(t *testing.T) {
 		assert.Equal(t, test.isNil, isNil, test.tag)
 	}
 }
+
+type Money struct {
+	Cents int64
+}
+
+func (m Money) IsZero() bool {
+	return m.Cents == 0
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d.%02d", m.Cents/100, m.Cents%100), nil
+}
+
+// Tags is empty if it only contains the placeholder "-".
+type Tags []string
+
+func (t Tags) IsEmpty() bool {
+	return len(t) == 1 && t[0] == "-"
+}
+
+type Option[T any] struct {
+	value T
+	set   bool
+}
+
+func (o Option[T]) Get() (interface{}, bool) {
+	return o.value, o.set
+}
+
+func (o Option[T]) isTestOption() {}
+
+// init registers the unwrap function of Option. The registration applies to all tests of the package,
+// so the function only matches Option, through its unexported isTestOption method.
+func init() {
+	validation.RegisterUnwrapFunc(func(value interface{}) (interface{}, bool) {
+		if o, ok := value.(interface {
+			Get() (interface{}, bool)
+			isTestOption()
+		}); ok {
+			if v, ok := o.Get(); ok {
+				return v, true
+			}
+			return nil, true
+		}
+		return nil, false
+	})
+}
+
+func TestRequiredWithEmptiers(t *testing.T) {
+	assert.Equal(t, validation.ErrRequired, validation.Required.Validate(Money{}))
+	assert.Nil(t, validation.Required.Validate(Money{Cents: 1}))
+	assert.Equal(t, validation.ErrRequired, validation.Required.Validate(netip.Addr{}))
+	assert.Equal(t, validation.ErrRequired, validation.Required.Validate(Option[string]{}))
+	assert.Equal(t, validation.ErrRequired, validation.Required.Validate(Option[string]{set: true}))
+	assert.Nil(t, validation.Required.Validate(Option[string]{value: "a", set: true}))
+	assert.Nil(t, validation.NilOrNotEmpty.Validate(Option[string]{}))
+}
@@ -60,6 +60,8 @@ var (
 //     Return with the validation result.
 //  3. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //     for each element call the element value's `Validate()`. Return with the validation result.
+//     The elements of a slice, or of an array given as a pointer, are addressable, so they are also validated
+//     if only the pointer to the element type implements `Validatable`.
 func Validate(value interface{}, rules ...Rule) error {
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
@@ -82,13 +84,20 @@ func Validate(value interface{}, rules ...Rule) error {
 	switch rv.Kind() {
 	case reflect.Map:
 		if rv.Type().Elem().Implements(validatableType) {
-			return validateMap(rv)
+			return validateMap(nil, rv)
 		}
 	case reflect.Slice, reflect.Array:
 		if rv.Type().Elem().Implements(validatableType) {
-			return validateSlice(rv)
+			return validateSlice(nil, rv, false)
+		}
+		if canAddrElements(rv) && reflect.PointerTo(rv.Type().Elem()).Implements(validatableType) {
+			return validateSlice(nil, rv, true)
 		}
 	case reflect.Ptr, reflect.Interface:
+		if e := rv.Elem(); e.Kind() == reflect.Array && canAddrElements(e) && reflect.PointerTo(e.Type().Elem()).Implements(validatableType) {
+			// validate the elements of the array in place, keeping them addressable
+			return validateSlice(nil, e, true)
+		}
 		return Validate(rv.Elem().Interface())
 	}
 
@@ -108,6 +117,15 @@ func Validate(value interface{}, rules ...Rule) error {
 //     for each element call the element value's `ValidateWithContext()`. Return with the validation result.
 //  5. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //     for each element call the element value's `Validate()`. Return with the validation result.
+//
+// As with Validate, the addressable elements of a slice or array are validated through their pointers
+// if only the pointer to the element type implements `ValidatableWithContext` or `Validatable`.
+//
+// Before descending into a value in steps 2-5, ValidateWithContext checks that the value is not already being
+// validated by an enclosing call with the same context, and that values are not nested deeper than the limit
+// set by WithMaxDepth. Otherwise it returns an InternalError wrapping ErrCycle or ErrDepthExceeded.
+// The enclosing calls can only be seen if the context is passed along, so cyclic data should implement
+// ValidatableWithContext and use ValidateStructWithContext or ValidateWithContext with the given context.
 func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule) error {
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
@@ -127,6 +145,13 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 		return nil
 	}
 
+	if rv.IsValid() && canDescend(rv) {
+		var err error
+		if ctx, err = enterValue(ctx, rv); err != nil {
+			return err
+		}
+	}
+
 	if v, ok := value.(ValidatableWithContext); ok {
 		return v.ValidateWithContext(ctx)
 	}
@@ -141,86 +166,135 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 			return validateMapWithContext(ctx, rv)
 		}
 		if rv.Type().Elem().Implements(validatableType) {
-			return validateMap(rv)
+			return validateMap(ctx, rv)
 		}
 	case reflect.Slice, reflect.Array:
 		if rv.Type().Elem().Implements(validatableWithContextType) {
-			return validateSliceWithContext(ctx, rv)
+			return validateSliceWithContext(ctx, rv, false)
 		}
 		if rv.Type().Elem().Implements(validatableType) {
-			return validateSlice(rv)
+			return validateSlice(ctx, rv, false)
+		}
+		if canAddrElements(rv) && isPointerValidatable(rv.Type().Elem()) {
+			return validateAddressableElements(ctx, rv)
 		}
 	case reflect.Ptr, reflect.Interface:
+		if e := rv.Elem(); e.Kind() == reflect.Array && canAddrElements(e) && isPointerValidatable(e.Type().Elem()) {
+			// validate the elements of the array in place, keeping them addressable
+			return validateAddressableElements(ctx, e)
+		}
 		return ValidateWithContext(ctx, rv.Elem().Interface())
 	}
 
 	return nil
 }
 
-// validateMap validates a map of validatable elements
-func validateMap(rv reflect.Value) error {
-	errs := Errors{}
-	for _, key := range rv.MapKeys() {
+// validateMap validates a map of validatable elements.
+// The given context is only used to look up the error budget and may be nil.
+func validateMap(ctx context.Context, rv reflect.Value) error {
+	c := newErrorCollector(ctx, Errors{})
+	keys := rv.MapKeys()
+	for i, key := range keys {
+		if c.stop(len(keys) - i) {
+			break
+		}
 		if mv := rv.MapIndex(key).Interface(); mv != nil {
-			if err := mv.(Validatable).Validate(); err != nil {
-				errs[fmt.Sprintf("%v", key.Interface())] = err
-			}
+			mark := c.mark()
+			c.add(fmt.Sprintf("%v", key.Interface()), mv.(Validatable).Validate(), mark)
 		}
 	}
-	if len(errs) > 0 {
-		return errs
-	}
-	return nil
+	return c.result()
 }
 
 // validateMapWithContext validates a map of validatable elements with the given context.
 func validateMapWithContext(ctx context.Context, rv reflect.Value) error {
-	errs := Errors{}
-	for _, key := range rv.MapKeys() {
+	c := newErrorCollector(ctx, Errors{})
+	keys := rv.MapKeys()
+	for i, key := range keys {
+		if c.stop(len(keys) - i) {
+			break
+		}
 		if mv := rv.MapIndex(key).Interface(); mv != nil {
-			if err := mv.(ValidatableWithContext).ValidateWithContext(ctx); err != nil {
-				errs[fmt.Sprintf("%v", key.Interface())] = err
+			mark := c.mark()
+			err := mv.(ValidatableWithContext).ValidateWithContext(ctx)
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
 			}
+			c.add(fmt.Sprintf("%v", key.Interface()), err, mark)
 		}
 	}
-	if len(errs) > 0 {
-		return errs
-	}
-	return nil
+	return c.result()
 }
 
-// validateSlice validates a slice/array of validatable elements
-func validateSlice(rv reflect.Value) error {
-	errs := Errors{}
+// validateSlice validates a slice/array of validatable elements, or of addressable elements
+// whose pointers are validatable if addr is true.
+// The given context is only used to look up the error budget and may be nil.
+func validateSlice(ctx context.Context, rv reflect.Value, addr bool) error {
+	c := newErrorCollector(ctx, Errors{})
 	l := rv.Len()
 	for i := 0; i < l; i++ {
-		if ev := rv.Index(i).Interface(); ev != nil {
-			if err := ev.(Validatable).Validate(); err != nil {
-				errs[strconv.Itoa(i)] = err
-			}
+		if c.stop(l - i) {
+			break
+		}
+		if ev := elementInterface(rv.Index(i), addr); ev != nil {
+			mark := c.mark()
+			c.add(strconv.Itoa(i), ev.(Validatable).Validate(), mark)
 		}
 	}
-	if len(errs) > 0 {
-		return errs
-	}
-	return nil
+	return c.result()
 }
 
-// validateSliceWithContext validates a slice/array of validatable elements with the given context.
-func validateSliceWithContext(ctx context.Context, rv reflect.Value) error {
-	errs := Errors{}
+// validateSliceWithContext validates a slice/array of validatable elements with the given context,
+// or of addressable elements whose pointers are validatable if addr is true.
+func validateSliceWithContext(ctx context.Context, rv reflect.Value, addr bool) error {
+	c := newErrorCollector(ctx, Errors{})
 	l := rv.Len()
 	for i := 0; i < l; i++ {
-		if ev := rv.Index(i).Interface(); ev != nil {
-			if err := ev.(ValidatableWithContext).ValidateWithContext(ctx); err != nil {
-				errs[strconv.Itoa(i)] = err
+		if c.stop(l - i) {
+			break
+		}
+		if ev := elementInterface(rv.Index(i), addr); ev != nil {
+			mark := c.mark()
+			err := ev.(ValidatableWithContext).ValidateWithContext(ctx)
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
 			}
+			c.add(strconv.Itoa(i), err, mark)
 		}
 	}
-	if len(errs) > 0 {
-		return errs
+	return c.result()
+}
+
+// validateAddressableElements validates the addressable elements of a slice/array through their pointers.
+func validateAddressableElements(ctx context.Context, rv reflect.Value) error {
+	if reflect.PointerTo(rv.Type().Elem()).Implements(validatableWithContextType) {
+		return validateSliceWithContext(ctx, rv, true)
 	}
-	return nil
+	return validateSlice(ctx, rv, true)
+}
+
+// elementInterface returns the element of a slice/array, or the pointer to it if addr is true.
+func elementInterface(ev reflect.Value, addr bool) interface{} {
+	if addr {
+		return ev.Addr().Interface()
+	}
+	return ev.Interface()
+}
+
+// canAddrElements checks if the elements of a slice/array are addressable.
+func canAddrElements(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Slice || rv.CanAddr()
+}
+
+// isPointerValidatable checks if only the pointer to the given type implements Validatable or
+// ValidatableWithContext, i.e. the type has validation methods with pointer receivers.
+func isPointerValidatable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface ||
+		t.Implements(validatableWithContextType) || t.Implements(validatableType) {
+		return false
+	}
+	pt := reflect.PointerTo(t)
+	return pt.Implements(validatableWithContextType) || pt.Implements(validatableType)
 }
 
 type skipRule struct {